@@ -0,0 +1,79 @@
+package seq
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"errors"
+	"io"
+	"iter"
+)
+
+// TarEntry is an entry of a tar archive yielded by [TarEntriesErr].
+type TarEntry struct {
+	Header *tar.Header
+
+	// Reader reads the contents of the entry.
+	// It is only valid until the next iteration.
+	Reader io.Reader
+}
+
+// TarEntries creates an iterator over the entries of a tar archive read from r.
+//
+// The iterator yields the header of each entry and a reader for its contents.
+// The reader is only valid until the next iteration.
+//
+// The iteration stops at the end of the archive or on the first error.
+// Use [TarEntriesErr] to find out whether the archive was read successfully.
+func TarEntries(r io.Reader) iter.Seq2[*tar.Header, io.Reader] {
+	return func(yield func(*tar.Header, io.Reader) bool) {
+		for entry, err := range TarEntriesErr(r) {
+			if err != nil {
+				return
+			}
+
+			if !yield(entry.Header, entry.Reader) {
+				return
+			}
+		}
+	}
+}
+
+// TarEntriesErr creates an iterator over the entries of a tar archive read from r.
+//
+// If reading the archive fails, the iterator yields the error (along with an empty entry) and stops.
+func TarEntriesErr(r io.Reader) iter.Seq2[TarEntry, error] {
+	return func(yield func(TarEntry, error) bool) {
+		reader := tar.NewReader(r)
+
+		for {
+			header, err := reader.Next()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+
+			if err != nil {
+				yield(TarEntry{}, err)
+
+				return
+			}
+
+			if !yield(TarEntry{Header: header, Reader: reader}, nil) {
+				return
+			}
+		}
+	}
+}
+
+// ZipEntries creates an iterator over the files of a zip archive.
+//
+// The iterator yields the name of each file along with the file itself.
+// The contents of a file are only read when the consumer opens it (see [zip.File.Open]).
+func ZipEntries(r *zip.Reader) iter.Seq2[string, *zip.File] {
+	return func(yield func(string, *zip.File) bool) {
+		for _, file := range r.File {
+			if !yield(file.Name, file) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package seq_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/sagikazarmark/seq"
+)
+
+func ExampleTarEntries() {
+	var buf bytes.Buffer
+
+	w := tar.NewWriter(&buf)
+
+	for _, name := range []string{"README.md", "main.go", "util.go"} {
+		_ = w.WriteHeader(&tar.Header{Name: name, Mode: 0o600, Size: int64(len(name))})
+		_, _ = w.Write([]byte(name))
+	}
+
+	_ = w.Close()
+
+	entries := seq.TarEntries(&buf)
+
+	goFiles := seq.Filter2(entries, func(h *tar.Header, _ io.Reader) bool {
+		return strings.HasSuffix(h.Name, ".go")
+	})
+
+	for header := range goFiles {
+		fmt.Println(header.Name)
+	}
+
+	// Output:
+	// main.go
+	// util.go
+}
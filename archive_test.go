@@ -0,0 +1,195 @@
+package seq_test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/sagikazarmark/seq"
+)
+
+var archiveFiles = []struct {
+	name    string
+	content string
+}{
+	{"README.md", "readme"},
+	{"main.go", "package main"},
+	{"util/util.go", "package util"},
+}
+
+func newTar(t *testing.T) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	w := tar.NewWriter(&buf)
+
+	for _, file := range archiveFiles {
+		if err := w.WriteHeader(&tar.Header{Name: file.name, Mode: 0o600, Size: int64(len(file.content))}); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := w.Write([]byte(file.content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func newZip(t *testing.T) *zip.Reader {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	w := zip.NewWriter(&buf)
+
+	for _, file := range archiveFiles {
+		f, err := w.Create(file.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := f.Write([]byte(file.content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return r
+}
+
+func TestTarEntries(t *testing.T) {
+	var actual []string
+
+	for header, r := range seq.TarEntries(bytes.NewReader(newTar(t))) {
+		content, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		actual = append(actual, header.Name+": "+string(content))
+	}
+
+	expected := []string{"README.md: readme", "main.go: package main", "util/util.go: package util"}
+
+	if !slices.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+func TestTarEntries_Filter(t *testing.T) {
+	entries := seq.TarEntries(bytes.NewReader(newTar(t)))
+
+	goFiles := seq.Filter2(entries, func(h *tar.Header, _ io.Reader) bool {
+		return strings.HasSuffix(h.Name, ".go")
+	})
+
+	var actual []string
+
+	for header := range seq.Take2(goFiles, 1) {
+		actual = append(actual, header.Name)
+	}
+
+	if expected := []string{"main.go"}; !slices.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+func TestTarEntriesErr(t *testing.T) {
+	archive := newTar(t)
+
+	testCases := []struct {
+		name     string
+		input    []byte
+		expected []string
+		err      bool
+	}{
+		{"valid_archive", archive, []string{"README.md", "main.go", "util/util.go"}, false},
+		{"empty_input", []byte{}, nil, false},
+		{"truncated_archive", archive[:1100], []string{"README.md"}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				actual []string
+				errs   int
+			)
+
+			for entry, err := range seq.TarEntriesErr(bytes.NewReader(tc.input)) {
+				if err != nil {
+					errs++
+
+					continue
+				}
+
+				actual = append(actual, entry.Header.Name)
+			}
+
+			if !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+
+			if tc.err && errs != 1 {
+				t.Errorf("expected exactly one error, got %d", errs)
+			} else if !tc.err && errs != 0 {
+				t.Errorf("expected no errors, got %d", errs)
+			}
+		})
+	}
+}
+
+func TestZipEntries(t *testing.T) {
+	var actual []string
+
+	for name, file := range seq.ZipEntries(newZip(t)) {
+		r, err := file.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		content, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_ = r.Close()
+
+		actual = append(actual, name+": "+string(content))
+	}
+
+	expected := []string{"README.md: readme", "main.go: package main", "util/util.go: package util"}
+
+	if !slices.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+func TestZipEntries_StopEarly(t *testing.T) {
+	var actual []string
+
+	for name := range seq.Take2(seq.ZipEntries(newZip(t)), 2) {
+		actual = append(actual, name)
+	}
+
+	if expected := []string{"README.md", "main.go"}; !slices.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
@@ -0,0 +1,281 @@
+package seq
+
+import (
+	"iter"
+	"slices"
+)
+
+// SliceMode determines how combinatoric iterators (e.g. [Permutations]) allocate the slices they yield.
+type SliceMode int
+
+const (
+	// CloneSlice allocates a new slice for every yielded value.
+	//
+	// Yielded slices are safe to retain and modify.
+	CloneSlice SliceMode = iota
+
+	// ReuseSlice yields the same slice over and over, overwriting its contents between iterations.
+	//
+	// Yielded slices are only valid until the next iteration and must not be modified.
+	// Use this mode to avoid allocations when results are consumed immediately.
+	ReuseSlice
+)
+
+func yieldSlice[V any](yield func([]V) bool, s []V, mode SliceMode) bool {
+	if mode == ReuseSlice {
+		return yield(s)
+	}
+
+	return yield(slices.Clone(s))
+}
+
+// CartesianProduct creates an iterator over the cartesian product of the input sequences.
+//
+// Each yielded slice contains one value from every sequence (in the order the sequences are provided).
+// The last sequence advances the fastest (similar to nested loops).
+//
+// The first sequence is consumed lazily, the rest of the sequences are collected when the iteration starts
+// (since they need to be iterated over multiple times).
+// The product itself is never materialized.
+//
+// If no sequences are provided, a single empty slice is yielded.
+// If any of the sequences is empty, nothing is yielded.
+//
+// Unlike the other combinatoric iterators, mode is the first parameter,
+// because the sequences are accepted as a variadic parameter (which has to come last).
+func CartesianProduct[V any](mode SliceMode, seqs ...iter.Seq[V]) iter.Seq[[]V] {
+	return func(yield func([]V) bool) {
+		if len(seqs) == 0 {
+			yieldSlice(yield, []V{}, mode)
+
+			return
+		}
+
+		pools := make([][]V, len(seqs)-1)
+
+		for i, seq := range seqs[1:] {
+			pools[i] = slices.Collect(seq)
+
+			if len(pools[i]) == 0 {
+				return
+			}
+		}
+
+		result := make([]V, len(seqs))
+		indices := make([]int, len(pools))
+
+		for v := range seqs[0] {
+			result[0] = v
+
+			clear(indices)
+
+			for {
+				for i, pool := range pools {
+					result[i+1] = pool[indices[i]]
+				}
+
+				if !yieldSlice(yield, result, mode) {
+					return
+				}
+
+				// Advance the indices like an odometer
+				i := len(indices) - 1
+
+				for ; i >= 0; i-- {
+					indices[i]++
+
+					if indices[i] < len(pools[i]) {
+						break
+					}
+
+					indices[i] = 0
+				}
+
+				if i < 0 {
+					break
+				}
+			}
+		}
+	}
+}
+
+// Permutations creates an iterator over all r-length permutations of the elements of s.
+//
+// Permutations are yielded in lexicographic order of the element positions.
+// Elements are treated as unique based on their position, not their value.
+//
+// If r is greater than the length of s, nothing is yielded.
+// If r is zero, a single empty slice is yielded.
+func Permutations[V any](s []V, r uint, mode SliceMode) iter.Seq[[]V] {
+	return func(yield func([]V) bool) {
+		n, k := len(s), int(r)
+
+		if k > n {
+			return
+		}
+
+		indices := make([]int, n)
+		for i := range indices {
+			indices[i] = i
+		}
+
+		cycles := make([]int, k)
+		for i := range cycles {
+			cycles[i] = n - i
+		}
+
+		result := make([]V, k)
+
+		emit := func() bool {
+			for i, idx := range indices[:k] {
+				result[i] = s[idx]
+			}
+
+			return yieldSlice(yield, result, mode)
+		}
+
+		if !emit() {
+			return
+		}
+
+	next:
+		for {
+			for i := k - 1; i >= 0; i-- {
+				cycles[i]--
+
+				if cycles[i] == 0 {
+					// Rotate the remaining indices to the left
+					first := indices[i]
+					copy(indices[i:], indices[i+1:])
+					indices[n-1] = first
+
+					cycles[i] = n - i
+
+					continue
+				}
+
+				j := n - cycles[i]
+				indices[i], indices[j] = indices[j], indices[i]
+
+				if !emit() {
+					return
+				}
+
+				continue next
+			}
+
+			return
+		}
+	}
+}
+
+// Combinations creates an iterator over all r-length combinations of the elements of s.
+//
+// Combinations are yielded in lexicographic order of the element positions.
+// Elements are treated as unique based on their position, not their value.
+//
+// If r is greater than the length of s, nothing is yielded.
+// If r is zero, a single empty slice is yielded.
+func Combinations[V any](s []V, r uint, mode SliceMode) iter.Seq[[]V] {
+	return func(yield func([]V) bool) {
+		n, k := len(s), int(r)
+
+		if k > n {
+			return
+		}
+
+		indices := make([]int, k)
+		for i := range indices {
+			indices[i] = i
+		}
+
+		result := make([]V, k)
+
+		for {
+			for i, idx := range indices {
+				result[i] = s[idx]
+			}
+
+			if !yieldSlice(yield, result, mode) {
+				return
+			}
+
+			// Find the rightmost index that can be incremented
+			i := k - 1
+			for ; i >= 0 && indices[i] == i+n-k; i-- {
+			}
+
+			if i < 0 {
+				return
+			}
+
+			indices[i]++
+
+			for j := i + 1; j < k; j++ {
+				indices[j] = indices[j-1] + 1
+			}
+		}
+	}
+}
+
+// CombinationsWithReplacement creates an iterator over all r-length combinations of the elements of s
+// allowing individual elements to be repeated.
+//
+// Combinations are yielded in lexicographic order of the element positions.
+// Elements are treated as unique based on their position, not their value.
+//
+// If s is empty and r is greater than zero, nothing is yielded.
+// If r is zero, a single empty slice is yielded.
+func CombinationsWithReplacement[V any](s []V, r uint, mode SliceMode) iter.Seq[[]V] {
+	return func(yield func([]V) bool) {
+		n, k := len(s), int(r)
+
+		if n == 0 && k > 0 {
+			return
+		}
+
+		indices := make([]int, k)
+		result := make([]V, k)
+
+		for {
+			for i, idx := range indices {
+				result[i] = s[idx]
+			}
+
+			if !yieldSlice(yield, result, mode) {
+				return
+			}
+
+			// Find the rightmost index that can be incremented
+			i := k - 1
+			for ; i >= 0 && indices[i] == n-1; i-- {
+			}
+
+			if i < 0 {
+				return
+			}
+
+			v := indices[i] + 1
+
+			for j := i; j < k; j++ {
+				indices[j] = v
+			}
+		}
+	}
+}
+
+// PowerSet creates an iterator over all subsets of the elements of s.
+//
+// Subsets are yielded in increasing size (starting with the empty set),
+// subsets of the same size are yielded in the same order as [Combinations].
+func PowerSet[V any](s []V, mode SliceMode) iter.Seq[[]V] {
+	return func(yield func([]V) bool) {
+		for r := range len(s) + 1 {
+			for subset := range Combinations(s, uint(r), mode) {
+				if !yield(subset) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package seq_test
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/sagikazarmark/seq"
+)
+
+func ExampleCartesianProduct() {
+	sizes := slices.Values([]string{"S", "M"})
+	colors := slices.Values([]string{"red", "blue"})
+
+	for variant := range seq.CartesianProduct(seq.ReuseSlice, sizes, colors) {
+		fmt.Println(variant)
+	}
+
+	// Output:
+	// [S red]
+	// [S blue]
+	// [M red]
+	// [M blue]
+}
+
+func ExamplePermutations() {
+	for p := range seq.Permutations([]string{"a", "b", "c"}, 2, seq.ReuseSlice) {
+		fmt.Println(p)
+	}
+
+	// Output:
+	// [a b]
+	// [a c]
+	// [b a]
+	// [b c]
+	// [c a]
+	// [c b]
+}
+
+func ExampleCombinations() {
+	for c := range seq.Combinations([]string{"a", "b", "c"}, 2, seq.ReuseSlice) {
+		fmt.Println(c)
+	}
+
+	// Output:
+	// [a b]
+	// [a c]
+	// [b c]
+}
+
+func ExampleCombinationsWithReplacement() {
+	for c := range seq.CombinationsWithReplacement([]string{"a", "b"}, 2, seq.ReuseSlice) {
+		fmt.Println(c)
+	}
+
+	// Output:
+	// [a a]
+	// [a b]
+	// [b b]
+}
+
+func ExamplePowerSet() {
+	subsets := slices.Collect(seq.PowerSet([]string{"a", "b"}, seq.CloneSlice))
+
+	fmt.Println(subsets)
+
+	// Output:
+	// [[] [a] [b] [a b]]
+}
@@ -0,0 +1,207 @@
+package seq_test
+
+import (
+	"iter"
+	"slices"
+	"testing"
+
+	"github.com/sagikazarmark/seq"
+)
+
+func collectSlices[V any](s iter.Seq[[]V]) [][]V {
+	result := [][]V{}
+
+	for v := range s {
+		result = append(result, v)
+	}
+
+	return result
+}
+
+func equalSlices[V comparable](a [][]V, b [][]V) bool {
+	return slices.EqualFunc(a, b, func(x []V, y []V) bool { return slices.Equal(x, y) })
+}
+
+func TestCartesianProduct(t *testing.T) {
+	testCases := []struct {
+		name     string
+		seqs     []iter.Seq[int]
+		expected [][]int
+	}{
+		{"no_sequences", []iter.Seq[int]{}, [][]int{{}}},
+		{"single_sequence", []iter.Seq[int]{slices.Values([]int{1, 2})}, [][]int{{1}, {2}}},
+		{"two_sequences", []iter.Seq[int]{slices.Values([]int{1, 2}), slices.Values([]int{3, 4})}, [][]int{{1, 3}, {1, 4}, {2, 3}, {2, 4}}},
+		{"three_sequences", []iter.Seq[int]{slices.Values([]int{1}), slices.Values([]int{2, 3}), slices.Values([]int{4, 5})}, [][]int{{1, 2, 4}, {1, 2, 5}, {1, 3, 4}, {1, 3, 5}}},
+		{"empty_first_sequence", []iter.Seq[int]{slices.Values([]int{}), slices.Values([]int{1})}, [][]int{}},
+		{"empty_last_sequence", []iter.Seq[int]{slices.Values([]int{1}), slices.Values([]int{})}, [][]int{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := collectSlices(seq.CartesianProduct(seq.CloneSlice, tc.seqs...))
+
+			if !equalSlices(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestPermutations(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    []int
+		r        uint
+		expected [][]int
+	}{
+		{"empty_slice", []int{}, 0, [][]int{{}}},
+		{"zero_length", []int{1, 2}, 0, [][]int{{}}},
+		{"r_greater_than_length", []int{1, 2}, 3, [][]int{}},
+		{"full_length", []int{1, 2, 3}, 3, [][]int{{1, 2, 3}, {1, 3, 2}, {2, 1, 3}, {2, 3, 1}, {3, 1, 2}, {3, 2, 1}}},
+		{"partial_length", []int{1, 2, 3}, 2, [][]int{{1, 2}, {1, 3}, {2, 1}, {2, 3}, {3, 1}, {3, 2}}},
+		{"duplicate_values", []int{1, 1}, 2, [][]int{{1, 1}, {1, 1}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := collectSlices(seq.Permutations(tc.input, tc.r, seq.CloneSlice))
+
+			if !equalSlices(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCombinations(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    []int
+		r        uint
+		expected [][]int
+	}{
+		{"empty_slice", []int{}, 0, [][]int{{}}},
+		{"zero_length", []int{1, 2}, 0, [][]int{{}}},
+		{"r_greater_than_length", []int{1, 2}, 3, [][]int{}},
+		{"full_length", []int{1, 2, 3}, 3, [][]int{{1, 2, 3}}},
+		{"partial_length", []int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := collectSlices(seq.Combinations(tc.input, tc.r, seq.CloneSlice))
+
+			if !equalSlices(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCombinationsWithReplacement(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    []int
+		r        uint
+		expected [][]int
+	}{
+		{"empty_slice", []int{}, 0, [][]int{{}}},
+		{"empty_slice_non_zero_length", []int{}, 1, [][]int{}},
+		{"zero_length", []int{1, 2}, 0, [][]int{{}}},
+		{"r_greater_than_length", []int{1, 2}, 3, [][]int{{1, 1, 1}, {1, 1, 2}, {1, 2, 2}, {2, 2, 2}}},
+		{"partial_length", []int{1, 2, 3}, 2, [][]int{{1, 1}, {1, 2}, {1, 3}, {2, 2}, {2, 3}, {3, 3}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := collectSlices(seq.CombinationsWithReplacement(tc.input, tc.r, seq.CloneSlice))
+
+			if !equalSlices(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestPowerSet(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    []int
+		expected [][]int
+	}{
+		{"empty_slice", []int{}, [][]int{{}}},
+		{"single_element", []int{1}, [][]int{{}, {1}}},
+		{"multiple_elements", []int{1, 2, 3}, [][]int{{}, {1}, {2}, {3}, {1, 2}, {1, 3}, {2, 3}, {1, 2, 3}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := collectSlices(seq.PowerSet(tc.input, seq.CloneSlice))
+
+			if !equalSlices(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSliceMode(t *testing.T) {
+	input := []int{1, 2, 3}
+
+	t.Run("clone", func(t *testing.T) {
+		var prev []int
+
+		for v := range seq.Permutations(input, 2, seq.CloneSlice) {
+			if prev != nil && &prev[0] == &v[0] {
+				t.Fatal("expected a new slice for every value")
+			}
+
+			prev = v
+		}
+	})
+
+	t.Run("reuse", func(t *testing.T) {
+		var prev []int
+
+		for v := range seq.Permutations(input, 2, seq.ReuseSlice) {
+			if prev != nil && &prev[0] != &v[0] {
+				t.Fatal("expected the same slice for every value")
+			}
+
+			prev = v
+		}
+	})
+}
+
+func TestCombinatorics_StopEarly(t *testing.T) {
+	input := []int{1, 2, 3, 4}
+
+	testCases := []struct {
+		name string
+		seq  iter.Seq[[]int]
+	}{
+		{"cartesian_product", seq.CartesianProduct(seq.ReuseSlice, slices.Values(input), slices.Values(input))},
+		{"permutations", seq.Permutations(input, 3, seq.ReuseSlice)},
+		{"combinations", seq.Combinations(input, 2, seq.ReuseSlice)},
+		{"combinations_with_replacement", seq.CombinationsWithReplacement(input, 2, seq.ReuseSlice)},
+		{"power_set", seq.PowerSet(input, seq.ReuseSlice)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var count int
+
+			for range tc.seq {
+				count++
+
+				if count == 3 {
+					break
+				}
+			}
+
+			if count != 3 {
+				t.Errorf("expected 3 values, got %d", count)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package seq
+
+import (
+	"errors"
+	"iter"
+	"sync/atomic"
+)
+
+// ErrAlreadyIterated is raised by [Once] (and its variants) when a single-use sequence is iterated more than once.
+var ErrAlreadyIterated = errors.New("sequence can only be iterated once")
+
+// Once creates an iterator that can only be iterated once.
+//
+// It is useful for iterators wrapping resources that cannot be rewound (e.g. readers or database cursors):
+// iterating over them again would silently yield nothing (or the remaining values).
+//
+// An iteration counts as used as soon as it starts, even if it is stopped early.
+// Subsequent iterations panic with [ErrAlreadyIterated].
+func Once[V any](seq iter.Seq[V]) iter.Seq[V] {
+	var used atomic.Bool
+
+	return func(yield func(V) bool) {
+		if used.Swap(true) {
+			panic(ErrAlreadyIterated)
+		}
+
+		seq(yield)
+	}
+}
+
+// Once2 creates an iterator that can only be iterated once.
+//
+// See [Once] for details.
+func Once2[K any, V any](seq iter.Seq2[K, V]) iter.Seq2[K, V] {
+	var used atomic.Bool
+
+	return func(yield func(K, V) bool) {
+		if used.Swap(true) {
+			panic(ErrAlreadyIterated)
+		}
+
+		seq(yield)
+	}
+}
+
+// OnceErr creates an iterator that can only be iterated once.
+//
+// Unlike [Once], subsequent iterations yield [ErrAlreadyIterated] (along with the zero value of V) instead of panicking.
+//
+// See [Once] for details.
+func OnceErr[V any](seq iter.Seq2[V, error]) iter.Seq2[V, error] {
+	var used atomic.Bool
+
+	return func(yield func(V, error) bool) {
+		if used.Swap(true) {
+			var zero V
+
+			yield(zero, ErrAlreadyIterated)
+
+			return
+		}
+
+		seq(yield)
+	}
+}
+
+// ContractViolation is the panic value raised by [Checked] when an iterator violates the iterator contract.
+type ContractViolation struct {
+	// Reason describes the violation.
+	Reason string
+}
+
+func (v *ContractViolation) Error() string {
+	return "iterator contract violation: " + v.Reason
+}
+
+// Checked creates an iterator that verifies that seq honors the iterator contract.
+//
+// It panics with a [*ContractViolation] if seq:
+//
+//   - calls yield again after it returned false
+//   - calls yield concurrently
+//   - calls yield again after it panicked
+//   - recovers from a panic in yield without propagating it
+//   - calls yield after the iteration ended
+//
+// These bugs are easy to make in custom iterators and hard to track down,
+// because they usually surface far away from the offending iterator (if at all).
+//
+// Checked is meant to be used while debugging and testing custom iterators.
+func Checked[V any](seq iter.Seq[V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		var checker yieldChecker
+
+		seq(func(v V) bool {
+			return checker.yield(func() bool { return yield(v) })
+		})
+
+		checker.finish()
+	}
+}
+
+// Checked2 creates an iterator that verifies that seq honors the iterator contract.
+//
+// See [Checked] for details.
+func Checked2[K any, V any](seq iter.Seq2[K, V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		var checker yieldChecker
+
+		seq(func(k K, v V) bool {
+			return checker.yield(func() bool { return yield(k, v) })
+		})
+
+		checker.finish()
+	}
+}
+
+const (
+	yieldReady int32 = iota
+	yieldStopped
+	yieldPanicked
+	yieldDone
+)
+
+// yieldChecker tracks the state of an iteration to detect contract violations.
+type yieldChecker struct {
+	state  atomic.Int32
+	active atomic.Bool
+}
+
+func (c *yieldChecker) yield(yield func() bool) bool {
+	switch c.state.Load() {
+	case yieldStopped:
+		panic(&ContractViolation{Reason: "yield called again after it returned false"})
+
+	case yieldPanicked:
+		panic(&ContractViolation{Reason: "yield called again after it panicked"})
+
+	case yieldDone:
+		panic(&ContractViolation{Reason: "yield called after the iteration ended"})
+	}
+
+	if !c.active.CompareAndSwap(false, true) {
+		panic(&ContractViolation{Reason: "yield called concurrently"})
+	}
+
+	completed := false
+
+	defer func() {
+		if !completed {
+			c.state.Store(yieldPanicked)
+		}
+
+		c.active.Store(false)
+	}()
+
+	ok := yield()
+	completed = true
+
+	if !ok {
+		c.state.Store(yieldStopped)
+	}
+
+	return ok
+}
+
+// finish should be called after the iterator returned (without panicking).
+func (c *yieldChecker) finish() {
+	if c.state.Swap(yieldDone) == yieldPanicked {
+		panic(&ContractViolation{Reason: "iterator recovered from a panic in yield without propagating it"})
+	}
+}
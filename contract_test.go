@@ -0,0 +1,262 @@
+package seq_test
+
+import (
+	"errors"
+	"iter"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/sagikazarmark/seq"
+)
+
+// catchPanic calls fn and returns the recovered panic value (if any).
+func catchPanic(fn func()) (r any) {
+	defer func() {
+		r = recover()
+	}()
+
+	fn()
+
+	return nil
+}
+
+func TestOnce(t *testing.T) {
+	once := seq.Once(slices.Values([]int{1, 2, 3}))
+
+	if actual, expected := slices.Collect(seq.Take(once, 1)), []int{1}; !slices.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+
+	r := catchPanic(func() {
+		for range once {
+		}
+	})
+
+	if err, ok := r.(error); !ok || !errors.Is(err, seq.ErrAlreadyIterated) {
+		t.Errorf("expected panic with %v, got %v", seq.ErrAlreadyIterated, r)
+	}
+}
+
+func TestOnce2(t *testing.T) {
+	once := seq.Once2(maps.All(map[string]int{"a": 1}))
+
+	if actual, expected := maps.Collect(once), map[string]int{"a": 1}; !maps.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+
+	r := catchPanic(func() {
+		for range once {
+		}
+	})
+
+	if err, ok := r.(error); !ok || !errors.Is(err, seq.ErrAlreadyIterated) {
+		t.Errorf("expected panic with %v, got %v", seq.ErrAlreadyIterated, r)
+	}
+}
+
+func TestOnceErr(t *testing.T) {
+	source := func(yield func(int, error) bool) {
+		for i := range 3 {
+			if !yield(i, nil) {
+				return
+			}
+		}
+	}
+
+	once := seq.OnceErr(source)
+
+	var actual []int
+
+	for v, err := range once {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		actual = append(actual, v)
+	}
+
+	if expected := []int{0, 1, 2}; !slices.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+
+	var errs []error
+
+	for v, err := range once {
+		if v != 0 {
+			t.Errorf("expected zero value, got %v", v)
+		}
+
+		errs = append(errs, err)
+	}
+
+	if len(errs) != 1 || !errors.Is(errs[0], seq.ErrAlreadyIterated) {
+		t.Errorf("expected a single %v, got %v", seq.ErrAlreadyIterated, errs)
+	}
+}
+
+func TestChecked(t *testing.T) {
+	var escaped func(int) bool
+
+	testCases := []struct {
+		name     string
+		seq      iter.Seq[int]
+		consume  func(iter.Seq[int])
+		expected string
+	}{
+		{
+			"yield_after_false",
+			func(yield func(int) bool) {
+				yield(1)
+				yield(2)
+			},
+			func(s iter.Seq[int]) {
+				s(func(int) bool { return false })
+			},
+			"yield called again after it returned false",
+		},
+		{
+			"yield_after_panic",
+			func(yield func(int) bool) {
+				defer func() {
+					_ = recover()
+
+					yield(2)
+				}()
+
+				yield(1)
+			},
+			func(s iter.Seq[int]) {
+				s(func(int) bool { panic("consumer failed") })
+			},
+			"yield called again after it panicked",
+		},
+		{
+			"swallowed_panic",
+			func(yield func(int) bool) {
+				defer func() {
+					_ = recover()
+				}()
+
+				yield(1)
+			},
+			func(s iter.Seq[int]) {
+				s(func(int) bool { panic("consumer failed") })
+			},
+			"iterator recovered from a panic in yield without propagating it",
+		},
+		{
+			"yield_after_end",
+			func(yield func(int) bool) {
+				escaped = yield
+			},
+			func(s iter.Seq[int]) {
+				s(func(int) bool { return true })
+
+				escaped(1)
+			},
+			"yield called after the iteration ended",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := catchPanic(func() {
+				tc.consume(seq.Checked(tc.seq))
+			})
+
+			violation, ok := r.(*seq.ContractViolation)
+			if !ok {
+				t.Fatalf("expected contract violation, got %v", r)
+			}
+
+			if violation.Reason != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, violation.Reason)
+			}
+		})
+	}
+}
+
+func TestChecked_Concurrent(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	var violation any
+
+	source := func(yield func(int) bool) {
+		done := make(chan struct{})
+
+		go func() {
+			defer close(done)
+
+			yield(1)
+		}()
+
+		<-started
+
+		violation = catchPanic(func() { yield(2) })
+
+		close(release)
+		<-done
+	}
+
+	seq.Checked(source)(func(v int) bool {
+		if v == 1 {
+			close(started)
+			<-release
+		}
+
+		return true
+	})
+
+	v, ok := violation.(*seq.ContractViolation)
+	if !ok {
+		t.Fatalf("expected contract violation, got %v", violation)
+	}
+
+	if expected := "yield called concurrently"; v.Reason != expected {
+		t.Errorf("expected %q, got %q", expected, v.Reason)
+	}
+}
+
+func TestChecked_Valid(t *testing.T) {
+	input := []int{1, 2, 3, 4}
+
+	for n := range uint(len(input) + 1) {
+		actual := slices.Collect(seq.Take(seq.Checked(slices.Values(input)), n))
+
+		if expected := input[:n]; !slices.Equal(actual, expected) {
+			t.Errorf("expected %v, got %v", expected, actual)
+		}
+	}
+
+	r := catchPanic(func() {
+		for range seq.Checked(slices.Values(input)) {
+			panic("consumer failed")
+		}
+	})
+
+	if r != "consumer failed" {
+		t.Errorf("expected consumer panic to propagate, got %v", r)
+	}
+}
+
+func TestChecked2(t *testing.T) {
+	source := func(yield func(string, int) bool) {
+		yield("a", 1)
+		yield("b", 2)
+	}
+
+	r := catchPanic(func() {
+		seq.Checked2(source)(func(string, int) bool { return false })
+	})
+
+	violation, ok := r.(*seq.ContractViolation)
+	if !ok {
+		t.Fatalf("expected contract violation, got %v", r)
+	}
+
+	if expected := "yield called again after it returned false"; violation.Reason != expected {
+		t.Errorf("expected %q, got %q", expected, violation.Reason)
+	}
+}
@@ -0,0 +1,269 @@
+package seq
+
+import (
+	"encoding"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"reflect"
+	"strconv"
+)
+
+// ReadCSV creates an iterator over the records read from r (using [csv.Reader]).
+//
+// If reading or parsing fails, the iterator yields the error (along with a nil record) and stops.
+func ReadCSV(r io.Reader) iter.Seq2[[]string, error] {
+	return func(yield func([]string, error) bool) {
+		reader := csv.NewReader(r)
+
+		for {
+			record, err := reader.Read()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+
+			if err != nil {
+				yield(nil, err)
+
+				return
+			}
+
+			if !yield(record, nil) {
+				return
+			}
+		}
+	}
+}
+
+// ReadCSVRecords creates an iterator over the records read from r (using [csv.Reader])
+// using the first record as a header.
+//
+// V must either be a map[string]string (or a type with the same underlying type) or a struct.
+//
+// Maps are populated with the header columns as keys.
+//
+// Struct fields are mapped to columns by the name in their csv tag (or the field name if there is no tag).
+// Fields tagged with "-", unexported fields and columns without a matching field are ignored.
+// Supported field types are strings, booleans, integers, floats and types implementing [encoding.TextUnmarshaler].
+// Empty values leave the field at its zero value (unless the field implements [encoding.TextUnmarshaler]).
+//
+// If reading, parsing or decoding fails, the iterator yields the error (along with the zero value of V) and stops.
+func ReadCSVRecords[V any](r io.Reader) iter.Seq2[V, error] {
+	return func(yield func(V, error) bool) {
+		var zero V
+
+		reader := csv.NewReader(r)
+
+		header, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return
+		}
+
+		if err != nil {
+			yield(zero, err)
+
+			return
+		}
+
+		decode, err := newCSVDecoder[V](header)
+		if err != nil {
+			yield(zero, err)
+
+			return
+		}
+
+		for {
+			record, err := reader.Read()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+
+			if err != nil {
+				yield(zero, err)
+
+				return
+			}
+
+			v, err := decode(record)
+			if err != nil {
+				line, _ := reader.FieldPos(0)
+
+				yield(zero, fmt.Errorf("record on line %d: %w", line, err))
+
+				return
+			}
+
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
+
+var textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+
+func newCSVDecoder[V any](header []string) (func([]string) (V, error), error) {
+	typ := reflect.TypeFor[V]()
+
+	switch {
+	case typ.Kind() == reflect.Map && typ.Key().Kind() == reflect.String && typ.Elem().Kind() == reflect.String:
+		return func(record []string) (V, error) {
+			m := reflect.MakeMapWithSize(typ, len(header))
+
+			for i, column := range header {
+				if i >= len(record) {
+					break
+				}
+
+				m.SetMapIndex(reflect.ValueOf(column).Convert(typ.Key()), reflect.ValueOf(record[i]).Convert(typ.Elem()))
+			}
+
+			return m.Interface().(V), nil
+		}, nil
+
+	case typ.Kind() == reflect.Struct:
+		fields := make(map[string]int)
+
+		for i := range typ.NumField() {
+			field := typ.Field(i)
+
+			if !field.IsExported() {
+				continue
+			}
+
+			name := field.Name
+
+			if tag, ok := field.Tag.Lookup("csv"); ok {
+				if tag == "-" {
+					continue
+				}
+
+				if tag != "" {
+					name = tag
+				}
+			}
+
+			if !reflect.PointerTo(field.Type).Implements(textUnmarshalerType) && !isCSVKind(field.Type.Kind()) {
+				return nil, fmt.Errorf("unsupported type %s for field %s", field.Type, field.Name)
+			}
+
+			fields[name] = i
+		}
+
+		indices := make([]int, len(header))
+
+		for i, column := range header {
+			index, ok := fields[column]
+			if !ok {
+				index = -1
+			}
+
+			indices[i] = index
+		}
+
+		return func(record []string) (V, error) {
+			var v V
+
+			rv := reflect.ValueOf(&v).Elem()
+
+			for i, index := range indices {
+				if index < 0 || i >= len(record) {
+					continue
+				}
+
+				if err := setCSVField(rv.Field(index), record[i]); err != nil {
+					var zero V
+
+					return zero, fmt.Errorf("column %q: %w", header[i], err)
+				}
+			}
+
+			return v, nil
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported record type %s: must be a struct or map[string]string", typ)
+}
+
+func isCSVKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+
+	return false
+}
+
+func setCSVField(field reflect.Value, value string) error {
+	if u, ok := field.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(value))
+	}
+
+	if field.Kind() == reflect.String {
+		field.SetString(value)
+
+		return nil
+	}
+
+	if value == "" {
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+
+		field.SetBool(b)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		field.SetInt(n)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(value, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		field.SetUint(n)
+
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(value, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		field.SetFloat(n)
+	}
+
+	return nil
+}
+
+// WriteCSV writes the records yielded by seq to w (using [csv.Writer]).
+//
+// It stops the iteration and returns the first write error.
+func WriteCSV(w io.Writer, seq iter.Seq[[]string]) error {
+	writer := csv.NewWriter(w)
+
+	for record := range seq {
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
@@ -0,0 +1,66 @@
+package seq_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/sagikazarmark/seq"
+)
+
+func ExampleReadCSV() {
+	r := strings.NewReader("alice,admin\nbob,user\n")
+
+	for record, err := range seq.ReadCSV(r) {
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Println(record)
+	}
+
+	// Output:
+	// [alice admin]
+	// [bob user]
+}
+
+func ExampleReadCSVRecords() {
+	type user struct {
+		Name string `csv:"name"`
+		Age  int    `csv:"age"`
+	}
+
+	r := strings.NewReader("name,age\nalice,30\nbob,25\n")
+
+	for u, err := range seq.ReadCSVRecords[user](r) {
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Printf("%s is %d\n", u.Name, u.Age)
+	}
+
+	// Output:
+	// alice is 30
+	// bob is 25
+}
+
+func ExampleWriteCSV() {
+	users := seq.Sorted2(map[string]string{"bob": "user", "alice": "admin"})
+
+	records := func(yield func([]string) bool) {
+		for name, role := range users {
+			if !yield([]string{name, role}) {
+				return
+			}
+		}
+	}
+
+	if err := seq.WriteCSV(os.Stdout, records); err != nil {
+		panic(err)
+	}
+
+	// Output:
+	// alice,admin
+	// bob,user
+}
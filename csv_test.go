@@ -0,0 +1,248 @@
+package seq_test
+
+import (
+	"bytes"
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sagikazarmark/seq"
+)
+
+func TestReadCSV(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected [][]string
+	}{
+		{"empty_input", "", [][]string{}},
+		{"single_record", "a,b,c\n", [][]string{{"a", "b", "c"}}},
+		{"multiple_records", "a,b\nc,d\n", [][]string{{"a", "b"}, {"c", "d"}}},
+		{"quoted_fields", "\"a,b\",\"c\"\"d\"\n", [][]string{{"a,b", `c"d`}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := [][]string{}
+
+			for record, err := range seq.ReadCSV(strings.NewReader(tc.input)) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				actual = append(actual, record)
+			}
+
+			if !equalSlices(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestReadCSV_Error(t *testing.T) {
+	var (
+		actual [][]string
+		errs   int
+	)
+
+	for record, err := range seq.ReadCSV(strings.NewReader("a,b\nc,d,e\nf,g\n")) {
+		if err != nil {
+			errs++
+
+			continue
+		}
+
+		actual = append(actual, record)
+	}
+
+	if expected := [][]string{{"a", "b"}}; !equalSlices(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+
+	if errs != 1 {
+		t.Errorf("expected exactly one error, got %d", errs)
+	}
+}
+
+func TestReadCSVRecords_Map(t *testing.T) {
+	input := "name,role\nalice,admin\nbob,user\n"
+
+	var actual []map[string]string
+
+	for record, err := range seq.ReadCSVRecords[map[string]string](strings.NewReader(input)) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		actual = append(actual, record)
+	}
+
+	expected := []map[string]string{
+		{"name": "alice", "role": "admin"},
+		{"name": "bob", "role": "user"},
+	}
+
+	if !slices.EqualFunc(actual, expected, maps.Equal) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+type csvUser struct {
+	Name     string `csv:"name"`
+	Age      int    `csv:"age"`
+	Score    float64
+	Active   bool      `csv:"active"`
+	Joined   time.Time `csv:"joined"`
+	Ignored  string    `csv:"-"`
+	internal string
+}
+
+func TestReadCSVRecords_Struct(t *testing.T) {
+	input := "name,age,Score,active,joined,Ignored,extra\n" +
+		"alice,30,1.5,true,2024-01-02T00:00:00Z,x,y\n" +
+		"bob,,,false,2024-02-03T00:00:00Z,,\n"
+
+	var actual []csvUser
+
+	for record, err := range seq.ReadCSVRecords[csvUser](strings.NewReader(input)) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		actual = append(actual, record)
+	}
+
+	expected := []csvUser{
+		{Name: "alice", Age: 30, Score: 1.5, Active: true, Joined: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{Name: "bob", Active: false, Joined: time.Date(2024, 2, 3, 0, 0, 0, 0, time.UTC)},
+	}
+
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, actual)
+	}
+
+	for i := range expected {
+		if actual[i].Name != expected[i].Name ||
+			actual[i].Age != expected[i].Age ||
+			actual[i].Score != expected[i].Score ||
+			actual[i].Active != expected[i].Active ||
+			!actual[i].Joined.Equal(expected[i].Joined) ||
+			actual[i].Ignored != "" {
+			t.Errorf("expected %v, got %v", expected[i], actual[i])
+		}
+	}
+}
+
+func TestReadCSVRecords_Error(t *testing.T) {
+	type unsupported struct {
+		Tags []string `csv:"tags"`
+	}
+
+	testCases := []struct {
+		name    string
+		input   string
+		records func(string) (int, error)
+		message string
+	}{
+		{
+			"invalid_value",
+			"name,age\nalice,30\nbob,old\n",
+			func(input string) (int, error) {
+				return collectErr(seq.ReadCSVRecords[csvUser](strings.NewReader(input)))
+			},
+			`record on line 3: column "age"`,
+		},
+		{
+			"unsupported_field_type",
+			"tags\na\n",
+			func(input string) (int, error) {
+				return collectErr(seq.ReadCSVRecords[unsupported](strings.NewReader(input)))
+			},
+			"unsupported type []string for field Tags",
+		},
+		{
+			"unsupported_record_type",
+			"a\n1\n",
+			func(input string) (int, error) { return collectErr(seq.ReadCSVRecords[int](strings.NewReader(input))) },
+			"unsupported record type int",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.records(tc.input)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+
+			if !strings.Contains(err.Error(), tc.message) {
+				t.Errorf("expected error to contain %q, got %v", tc.message, err)
+			}
+		})
+	}
+}
+
+func TestReadCSVRecords_Empty(t *testing.T) {
+	n, err := collectErr(seq.ReadCSVRecords[map[string]string](strings.NewReader("")))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if n != 0 {
+		t.Errorf("expected no records, got %d", n)
+	}
+}
+
+// collectErr counts the values yielded by seq until the first error.
+func collectErr[V any](seq func(yield func(V, error) bool)) (int, error) {
+	var n int
+
+	for _, err := range seq {
+		if err != nil {
+			return n, err
+		}
+
+		n++
+	}
+
+	return n, nil
+}
+
+func TestWriteCSV(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    [][]string
+		expected string
+	}{
+		{"empty_sequence", [][]string{}, ""},
+		{"single_record", [][]string{{"a", "b"}}, "a,b\n"},
+		{"quoted_fields", [][]string{{"a,b", `c"d`}, {"e", ""}}, "\"a,b\",\"c\"\"d\"\ne,\n"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			if err := seq.WriteCSV(&buf, slices.Values(tc.input)); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if buf.String() != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, buf.String())
+			}
+		})
+	}
+}
+
+func TestWriteCSV_WriteError(t *testing.T) {
+	writeErr := errors.New("write error")
+
+	err := seq.WriteCSV(errWriter{writeErr}, slices.Values([][]string{{"a"}}))
+	if !errors.Is(err, writeErr) {
+		t.Errorf("expected %v, got %v", writeErr, err)
+	}
+}
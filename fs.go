@@ -0,0 +1,208 @@
+package seq
+
+import (
+	"errors"
+	"io/fs"
+	"iter"
+	"path"
+	"strings"
+)
+
+// WalkEntry is a file or directory visited by [WalkDir].
+type WalkEntry struct {
+	fs.DirEntry
+
+	// Path is the path of the entry (relative to the root of the filesystem, see [fs.WalkDir]).
+	Path string
+
+	skip *bool
+}
+
+// SkipDir instructs [WalkDir] to skip the contents of the entry if it is a directory.
+//
+// If the entry is a file, the remaining files in the containing directory are skipped.
+// See [fs.SkipDir] for details.
+func (e WalkEntry) SkipDir() {
+	if e.skip != nil {
+		*e.skip = true
+	}
+}
+
+// WalkDir creates an iterator over the file tree rooted at root (using [fs.WalkDir]).
+//
+// Entries are yielded in lexical order.
+// Call [WalkEntry.SkipDir] on a directory entry to skip its contents.
+//
+// Errors are yielded along with the entry (if any) they relate to:
+// if root cannot be read, the iteration stops;
+// if a directory cannot be read, the directory is skipped and the iteration continues
+// (unless the consumer stops it).
+func WalkDir(fsys fs.FS, root string) iter.Seq2[WalkEntry, error] {
+	return func(yield func(WalkEntry, error) bool) {
+		_ = fs.WalkDir(fsys, root, func(p string, d fs.DirEntry, err error) error {
+			var skip bool
+
+			if !yield(WalkEntry{DirEntry: d, Path: p, skip: &skip}, err) {
+				return fs.SkipAll
+			}
+
+			if skip {
+				return fs.SkipDir
+			}
+
+			// Continue walking after a directory read error
+			if err != nil && d != nil && d.IsDir() {
+				return fs.SkipDir
+			}
+
+			return nil
+		})
+	}
+}
+
+// Glob creates an iterator over the names of files matching pattern in fsys.
+//
+// The pattern syntax is the same as in [path.Match] with the addition of "**",
+// which matches zero or more directories when used as a complete path element (e.g. "a/**/*.go").
+//
+// Unlike [fs.Glob], matches are found lazily by walking the file tree (in lexical order),
+// and directories that cannot contain any matches are skipped.
+//
+// If the pattern is malformed, the iterator yields [path.ErrBadPattern] and stops.
+// Other errors (e.g. a directory that cannot be read) are yielded and the iteration continues (unless the consumer stops it).
+func Glob(fsys fs.FS, pattern string) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		segments := strings.Split(pattern, "/")
+
+		// Find the longest prefix without meta characters
+		var (
+			root  []string
+			magic bool
+		)
+
+		for _, segment := range segments {
+			if segment != "**" {
+				if _, err := path.Match(segment, ""); err != nil {
+					yield("", err)
+
+					return
+				}
+			}
+
+			if !magic && !hasGlobMeta(segment) {
+				root = append(root, segment)
+
+				continue
+			}
+
+			magic = true
+		}
+
+		if !magic {
+			// Plain path: just check if it exists
+			if _, err := fs.Stat(fsys, pattern); err == nil {
+				yield(pattern, nil)
+			}
+
+			return
+		}
+
+		dir := "."
+		if len(root) > 0 {
+			dir = strings.Join(root, "/")
+		}
+
+		for entry, err := range WalkDir(fsys, dir) {
+			if err != nil {
+				// The pattern does not match anything if the root does not exist
+				if entry.Path == dir && errors.Is(err, fs.ErrNotExist) {
+					return
+				}
+
+				if !yield("", err) {
+					return
+				}
+
+				continue
+			}
+
+			if entry.Path == "." {
+				continue
+			}
+
+			elems := strings.Split(entry.Path, "/")
+
+			if globMatch(segments, elems) {
+				if !yield(entry.Path, nil) {
+					return
+				}
+			}
+
+			if entry.IsDir() && !globMatchPrefix(segments, elems) {
+				entry.SkipDir()
+			}
+		}
+	}
+}
+
+func hasGlobMeta(segment string) bool {
+	return strings.ContainsAny(segment, `*?[\`)
+}
+
+// globMatch reports whether path elements match the pattern segments.
+func globMatch(pattern []string, elems []string) bool {
+	if len(pattern) == 0 {
+		return len(elems) == 0
+	}
+
+	if pattern[0] == "**" {
+		return globMatch(pattern[1:], elems) || (len(elems) > 0 && globMatch(pattern, elems[1:]))
+	}
+
+	if len(elems) == 0 {
+		return false
+	}
+
+	ok, _ := path.Match(pattern[0], elems[0])
+
+	return ok && globMatch(pattern[1:], elems[1:])
+}
+
+// globMatchPrefix reports whether path elements of a directory could be a prefix of a match.
+func globMatchPrefix(pattern []string, elems []string) bool {
+	if len(elems) == 0 {
+		return true
+	}
+
+	if len(pattern) == 0 {
+		return false
+	}
+
+	if pattern[0] == "**" {
+		return true
+	}
+
+	ok, _ := path.Match(pattern[0], elems[0])
+
+	return ok && globMatchPrefix(pattern[1:], elems[1:])
+}
+
+// ReadDirSorted creates an iterator over the entries of the named directory, sorted by filename (using [fs.ReadDir]).
+//
+// If reading the directory fails, the iterator yields the entries read before the error,
+// then the error (along with a nil entry).
+func ReadDirSorted(fsys fs.FS, name string) iter.Seq2[fs.DirEntry, error] {
+	return func(yield func(fs.DirEntry, error) bool) {
+		entries, err := fs.ReadDir(fsys, name)
+
+		for _, entry := range entries {
+			if !yield(entry, nil) {
+				return
+			}
+		}
+
+		if err != nil {
+			yield(nil, err)
+		}
+	}
+}
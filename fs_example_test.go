@@ -0,0 +1,58 @@
+package seq_test
+
+import (
+	"fmt"
+	"testing/fstest"
+
+	"github.com/sagikazarmark/seq"
+)
+
+func ExampleWalkDir() {
+	fsys := fstest.MapFS{
+		"main.go":           {},
+		"pkg/util.go":       {},
+		"vendor/lib/lib.go": {},
+	}
+
+	for entry, err := range seq.WalkDir(fsys, ".") {
+		if err != nil {
+			panic(err)
+		}
+
+		if entry.IsDir() && entry.Name() == "vendor" {
+			entry.SkipDir()
+
+			continue
+		}
+
+		fmt.Println(entry.Path)
+	}
+
+	// Output:
+	// .
+	// main.go
+	// pkg
+	// pkg/util.go
+}
+
+func ExampleGlob() {
+	fsys := fstest.MapFS{
+		"main.go":         {},
+		"README.md":       {},
+		"pkg/util.go":     {},
+		"pkg/sub/deep.go": {},
+	}
+
+	for match, err := range seq.Glob(fsys, "**/*.go") {
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Println(match)
+	}
+
+	// Output:
+	// main.go
+	// pkg/sub/deep.go
+	// pkg/util.go
+}
@@ -0,0 +1,186 @@
+package seq_test
+
+import (
+	"errors"
+	"io/fs"
+	"path"
+	"slices"
+	"testing"
+	"testing/fstest"
+
+	"github.com/sagikazarmark/seq"
+)
+
+var testFS = fstest.MapFS{
+	"go.mod":                 {},
+	"main.go":                {},
+	"README.md":              {},
+	"cmd/app/main.go":        {},
+	"cmd/app/main_test.go":   {},
+	"internal/util/util.go":  {},
+	"internal/util/util.txt": {},
+	"vendor/lib/lib.go":      {},
+}
+
+func TestWalkDir(t *testing.T) {
+	var actual []string
+
+	for entry, err := range seq.WalkDir(testFS, "cmd") {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		actual = append(actual, entry.Path)
+	}
+
+	expected := []string{"cmd", "cmd/app", "cmd/app/main.go", "cmd/app/main_test.go"}
+
+	if !slices.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+func TestWalkDir_SkipDir(t *testing.T) {
+	var actual []string
+
+	for entry, err := range seq.WalkDir(testFS, ".") {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if entry.IsDir() && (entry.Name() == "vendor" || entry.Name() == "internal") {
+			entry.SkipDir()
+
+			continue
+		}
+
+		if !entry.IsDir() {
+			actual = append(actual, entry.Path)
+		}
+	}
+
+	expected := []string{"README.md", "cmd/app/main.go", "cmd/app/main_test.go", "go.mod", "main.go"}
+
+	if !slices.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+func TestWalkDir_StopEarly(t *testing.T) {
+	var actual []string
+
+	for entry, err := range seq.WalkDir(testFS, ".") {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		actual = append(actual, entry.Path)
+
+		if len(actual) == 3 {
+			break
+		}
+	}
+
+	expected := []string{".", "README.md", "cmd"}
+
+	if !slices.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+func TestWalkDir_Error(t *testing.T) {
+	var errs []error
+
+	for _, err := range seq.WalkDir(testFS, "missing") {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) != 1 || !errors.Is(errs[0], fs.ErrNotExist) {
+		t.Errorf("expected %v, got %v", fs.ErrNotExist, errs)
+	}
+}
+
+func TestGlob(t *testing.T) {
+	testCases := []struct {
+		name     string
+		pattern  string
+		expected []string
+	}{
+		{"plain_path", "go.mod", []string{"go.mod"}},
+		{"plain_path_not_found", "missing.go", nil},
+		{"wildcard", "*.go", []string{"main.go"}},
+		{"nested_wildcard", "cmd/*/*.go", []string{"cmd/app/main.go", "cmd/app/main_test.go"}},
+		{"double_star", "**/*.go", []string{"cmd/app/main.go", "cmd/app/main_test.go", "internal/util/util.go", "main.go", "vendor/lib/lib.go"}},
+		{"double_star_in_the_middle", "internal/**/*.txt", []string{"internal/util/util.txt"}},
+		{"double_star_at_the_end", "cmd/**", []string{"cmd", "cmd/app", "cmd/app/main.go", "cmd/app/main_test.go"}},
+		{"character_class", "internal/util/util.[gt]*", []string{"internal/util/util.go", "internal/util/util.txt"}},
+		{"missing_root", "missing/*.go", nil},
+		{"no_matches", "**/*.rs", nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual []string
+
+			for match, err := range seq.Glob(testFS, tc.pattern) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				actual = append(actual, match)
+			}
+
+			if !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestGlob_BadPattern(t *testing.T) {
+	var errs []error
+
+	for _, err := range seq.Glob(testFS, "cmd/[") {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) != 1 || !errors.Is(errs[0], path.ErrBadPattern) {
+		t.Errorf("expected %v, got %v", path.ErrBadPattern, errs)
+	}
+}
+
+func TestReadDirSorted(t *testing.T) {
+	var actual []string
+
+	for entry, err := range seq.ReadDirSorted(testFS, ".") {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		actual = append(actual, entry.Name())
+	}
+
+	expected := []string{"README.md", "cmd", "go.mod", "internal", "main.go", "vendor"}
+
+	if !slices.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+func TestReadDirSorted_Error(t *testing.T) {
+	var errs []error
+
+	for _, err := range seq.ReadDirSorted(testFS, "missing") {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) != 1 || !errors.Is(errs[0], fs.ErrNotExist) {
+		t.Errorf("expected %v, got %v", fs.ErrNotExist, errs)
+	}
+}
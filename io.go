@@ -0,0 +1,204 @@
+package seq
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+)
+
+// ScanOption configures the scanner used by [Scan], [Lines] and [LinesBytes].
+type ScanOption func(*scanConfig)
+
+type scanConfig struct {
+	maxTokenSize int
+}
+
+// WithMaxTokenSize sets the maximum size of a token (e.g. a line).
+//
+// The default is [bufio.MaxScanTokenSize].
+// If size is not positive, the default is used.
+// Tokens exceeding the maximum size result in an error wrapping [bufio.ErrTooLong].
+func WithMaxTokenSize(size int) ScanOption {
+	return func(c *scanConfig) {
+		if size > 0 {
+			c.maxTokenSize = size
+		}
+	}
+}
+
+func newScanner(r io.Reader, split bufio.SplitFunc, opts []ScanOption) (*bufio.Scanner, scanConfig) {
+	config := scanConfig{
+		maxTokenSize: bufio.MaxScanTokenSize,
+	}
+
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, min(4096, config.maxTokenSize)), config.maxTokenSize)
+
+	if split != nil {
+		scanner.Split(split)
+	}
+
+	return scanner, config
+}
+
+func scanErr(err error, config scanConfig) error {
+	if errors.Is(err, bufio.ErrTooLong) {
+		return fmt.Errorf("token exceeds maximum size of %d bytes: %w", config.maxTokenSize, err)
+	}
+
+	return err
+}
+
+// Scan creates an iterator over the tokens read from r using a split function (see [bufio.Scanner]).
+//
+// If split is nil, [bufio.ScanLines] is used.
+//
+// If reading fails, the iterator yields the error (along with an empty string) and stops.
+func Scan(r io.Reader, split bufio.SplitFunc, opts ...ScanOption) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		scanner, config := newScanner(r, split, opts)
+
+		for scanner.Scan() {
+			if !yield(scanner.Text(), nil) {
+				return
+			}
+		}
+
+		if err := scanner.Err(); err != nil {
+			yield("", scanErr(err, config))
+		}
+	}
+}
+
+// Lines creates an iterator over the lines read from r.
+//
+// Lines are yielded without the trailing end-of-line marker (see [bufio.ScanLines]).
+//
+// If reading fails, the iterator yields the error (along with an empty string) and stops.
+func Lines(r io.Reader, opts ...ScanOption) iter.Seq2[string, error] {
+	return Scan(r, bufio.ScanLines, opts...)
+}
+
+// LinesBytes creates an iterator over the lines read from r.
+//
+// Unlike [Lines], it yields the scanner's internal buffer without copying it.
+// Yielded slices are only valid until the next iteration and must not be modified.
+// Use this variant to avoid allocations when lines are consumed immediately.
+//
+// If reading fails, the iterator yields the error (along with a nil slice) and stops.
+func LinesBytes(r io.Reader, opts ...ScanOption) iter.Seq2[[]byte, error] {
+	return func(yield func([]byte, error) bool) {
+		scanner, config := newScanner(r, bufio.ScanLines, opts)
+
+		for scanner.Scan() {
+			if !yield(scanner.Bytes(), nil) {
+				return
+			}
+		}
+
+		if err := scanner.Err(); err != nil {
+			yield(nil, scanErr(err, config))
+		}
+	}
+}
+
+// WriteLines writes the values yielded by seq to w, each followed by a newline.
+//
+// It returns the number of bytes written.
+// It stops the iteration and returns the first write error.
+func WriteLines[S ~string | ~[]byte](w io.Writer, seq iter.Seq[S]) (int64, error) {
+	var (
+		buf     []byte
+		written int64
+	)
+
+	for line := range seq {
+		buf = append(buf[:0], line...)
+		buf = append(buf, '\n')
+
+		n, err := w.Write(buf)
+		written += int64(n)
+
+		if err != nil {
+			return written, err
+		}
+	}
+
+	return written, nil
+}
+
+// WriteFormatted writes the values yielded by seq to w, formatted according to a format specifier (see [fmt.Fprintf]).
+//
+// The format is applied to each value individually and should include a trailing newline if needed.
+//
+// It returns the number of bytes written.
+// It stops the iteration and returns the first write error.
+func WriteFormatted[V any](w io.Writer, seq iter.Seq[V], format string) (int64, error) {
+	var written int64
+
+	for v := range seq {
+		n, err := fmt.Fprintf(w, format, v)
+		written += int64(n)
+
+		if err != nil {
+			return written, err
+		}
+	}
+
+	return written, nil
+}
+
+// WriteFormatted2 writes the pairs yielded by seq to w, formatted according to a format specifier (see [fmt.Fprintf]).
+//
+// The format is applied to each pair individually (the key is the first operand, the value is the second one)
+// and should include a trailing newline if needed.
+//
+// Combined with [Sorted2], it can be used to write maps deterministically:
+//
+//	seq.WriteFormatted2(w, seq.Sorted2(config), "%s = %q\n")
+//
+// It returns the number of bytes written.
+// It stops the iteration and returns the first write error.
+func WriteFormatted2[K any, V any](w io.Writer, seq iter.Seq2[K, V], format string) (int64, error) {
+	var written int64
+
+	for k, v := range seq {
+		n, err := fmt.Fprintf(w, format, k, v)
+		written += int64(n)
+
+		if err != nil {
+			return written, err
+		}
+	}
+
+	return written, nil
+}
+
+// Join concatenates the values yielded by seq, placing sep between them.
+//
+// It works like [strings.Join], but without collecting the values into a slice first.
+func Join[S ~string](seq iter.Seq[S], sep string) string {
+	var (
+		b     strings.Builder
+		first = true
+	)
+
+	for s := range seq {
+		if !first {
+			b.WriteString(sep)
+		}
+
+		first = false
+
+		b.WriteString(string(s))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,81 @@
+package seq_test
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/sagikazarmark/seq"
+)
+
+func ExampleLines() {
+	r := strings.NewReader("apple\nbanana\ncherry\n")
+
+	for line, err := range seq.Lines(r) {
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Println(line)
+	}
+
+	// Output:
+	// apple
+	// banana
+	// cherry
+}
+
+func ExampleScan() {
+	r := strings.NewReader("the quick\nbrown fox")
+
+	for word, err := range seq.Scan(r, bufio.ScanWords) {
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Println(word)
+	}
+
+	// Output:
+	// the
+	// quick
+	// brown
+	// fox
+}
+
+func ExampleWriteLines() {
+	fruits := slices.Values([]string{"apple", "banana", "cherry"})
+
+	if _, err := seq.WriteLines(os.Stdout, seq.Map(fruits, strings.ToUpper)); err != nil {
+		panic(err)
+	}
+
+	// Output:
+	// APPLE
+	// BANANA
+	// CHERRY
+}
+
+func ExampleWriteFormatted2() {
+	config := map[string]string{"port": "8080", "host": "localhost"}
+
+	if _, err := seq.WriteFormatted2(os.Stdout, seq.Sorted2(config), "%s = %q\n"); err != nil {
+		panic(err)
+	}
+
+	// Output:
+	// host = "localhost"
+	// port = "8080"
+}
+
+func ExampleJoin() {
+	numbers := seq.Map(seq.Range(1, 4, 1), strconv.Itoa)
+
+	fmt.Println(seq.Join(numbers, ", "))
+
+	// Output:
+	// 1, 2, 3
+}
@@ -0,0 +1,320 @@
+package seq_test
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/sagikazarmark/seq"
+)
+
+func TestLines(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{"empty_input", "", nil},
+		{"single_line", "hello", []string{"hello"}},
+		{"multiple_lines", "hello\nworld\n", []string{"hello", "world"}},
+		{"crlf", "hello\r\nworld", []string{"hello", "world"}},
+		{"empty_lines", "a\n\nb", []string{"a", "", "b"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual []string
+
+			for line, err := range seq.Lines(strings.NewReader(tc.input)) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				actual = append(actual, line)
+			}
+
+			if !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestLines_TooLong(t *testing.T) {
+	var (
+		actual []string
+		errs   []error
+	)
+
+	for line, err := range seq.Lines(strings.NewReader("short\nthis line is too long\nshort"), seq.WithMaxTokenSize(10)) {
+		if err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+
+		actual = append(actual, line)
+	}
+
+	if expected := []string{"short"}; !slices.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+
+	if len(errs) != 1 {
+		t.Fatalf("expected exactly one error, got %v", errs)
+	}
+
+	if !errors.Is(errs[0], bufio.ErrTooLong) {
+		t.Errorf("expected error to wrap bufio.ErrTooLong, got %v", errs[0])
+	}
+
+	if !strings.Contains(errs[0].Error(), "10 bytes") {
+		t.Errorf("expected error to contain the maximum size, got %v", errs[0])
+	}
+}
+
+func TestLines_NonPositiveMaxTokenSize(t *testing.T) {
+	for _, size := range []int{0, -1} {
+		t.Run(strconv.Itoa(size), func(t *testing.T) {
+			var actual []string
+
+			for line, err := range seq.Lines(strings.NewReader("first\nsecond"), seq.WithMaxTokenSize(size)) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				actual = append(actual, line)
+			}
+
+			if expected := []string{"first", "second"}; !slices.Equal(actual, expected) {
+				t.Errorf("expected %v, got %v", expected, actual)
+			}
+		})
+	}
+}
+
+func TestLines_ReadError(t *testing.T) {
+	readErr := errors.New("read error")
+
+	var errs []error
+
+	for _, err := range seq.Lines(iotest.ErrReader(readErr)) {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) != 1 || !errors.Is(errs[0], readErr) {
+		t.Errorf("expected %v, got %v", readErr, errs)
+	}
+}
+
+func TestLinesBytes(t *testing.T) {
+	var actual []string
+
+	for line, err := range seq.LinesBytes(strings.NewReader("hello\nworld\n")) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		actual = append(actual, string(line))
+	}
+
+	if expected := []string{"hello", "world"}; !slices.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+func TestScan(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		split    bufio.SplitFunc
+		expected []string
+	}{
+		{"nil_split", "a b\nc", nil, []string{"a b", "c"}},
+		{"words", "hello  brave\nnew world", bufio.ScanWords, []string{"hello", "brave", "new", "world"}},
+		{"runes", "héllo", bufio.ScanRunes, []string{"h", "é", "l", "l", "o"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual []string
+
+			for token, err := range seq.Scan(strings.NewReader(tc.input), tc.split) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				actual = append(actual, token)
+			}
+
+			if !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestWriteLines(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    []string
+		expected string
+	}{
+		{"empty_sequence", []string{}, ""},
+		{"single_line", []string{"hello"}, "hello\n"},
+		{"multiple_lines", []string{"hello", "", "world"}, "hello\n\nworld\n"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			n, err := seq.WriteLines(&buf, slices.Values(tc.input))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if buf.String() != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, buf.String())
+			}
+
+			if n != int64(len(tc.expected)) {
+				t.Errorf("expected %d bytes written, got %d", len(tc.expected), n)
+			}
+		})
+	}
+}
+
+func TestWriteLines_Bytes(t *testing.T) {
+	var buf bytes.Buffer
+
+	if _, err := seq.WriteLines(&buf, slices.Values([][]byte{[]byte("hello"), []byte("world")})); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if expected := "hello\nworld\n"; buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+}
+
+// limitedWriter fails after writing n bytes.
+type limitedWriter struct {
+	n   int
+	err error
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if len(p) > w.n {
+		n := w.n
+		w.n = 0
+
+		return n, w.err
+	}
+
+	w.n -= len(p)
+
+	return len(p), nil
+}
+
+func TestWriteLines_WriteError(t *testing.T) {
+	writeErr := errors.New("write error")
+
+	var count int
+
+	lines := seq.Map(seq.Repeat("hello"), func(s string) string {
+		count++
+
+		return s
+	})
+
+	n, err := seq.WriteLines(&limitedWriter{n: 8, err: writeErr}, lines)
+	if !errors.Is(err, writeErr) {
+		t.Fatalf("expected %v, got %v", writeErr, err)
+	}
+
+	if n != 8 {
+		t.Errorf("expected 8 bytes written, got %d", n)
+	}
+
+	if count != 2 {
+		t.Errorf("expected the iteration to stop after the first error, got %d values", count)
+	}
+}
+
+func TestWriteFormatted(t *testing.T) {
+	var buf bytes.Buffer
+
+	n, err := seq.WriteFormatted(&buf, slices.Values([]int{1, 2, 3}), "- %03d\n")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := "- 001\n- 002\n- 003\n"
+
+	if buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+
+	if n != int64(len(expected)) {
+		t.Errorf("expected %d bytes written, got %d", len(expected), n)
+	}
+}
+
+func TestWriteFormatted_WriteError(t *testing.T) {
+	writeErr := errors.New("write error")
+
+	n, err := seq.WriteFormatted(&limitedWriter{n: 3, err: writeErr}, seq.Repeat(1), "%d\n")
+	if !errors.Is(err, writeErr) {
+		t.Fatalf("expected %v, got %v", writeErr, err)
+	}
+
+	if n != 3 {
+		t.Errorf("expected 3 bytes written, got %d", n)
+	}
+}
+
+func TestWriteFormatted2(t *testing.T) {
+	var buf bytes.Buffer
+
+	config := map[string]string{"port": "8080", "host": "localhost"}
+
+	if _, err := seq.WriteFormatted2(&buf, seq.Sorted2(config), "%s = %q\n"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if expected := "host = \"localhost\"\nport = \"8080\"\n"; buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestJoin(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    []string
+		sep      string
+		expected string
+	}{
+		{"empty_sequence", []string{}, ", ", ""},
+		{"single_element", []string{"a"}, ", ", "a"},
+		{"multiple_elements", []string{"a", "b", "c"}, ", ", "a, b, c"},
+		{"empty_separator", []string{"a", "b"}, "", "ab"},
+		{"empty_elements", []string{"", ""}, "-", "-"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := seq.Join(slices.Values(tc.input), tc.sep)
+
+			if actual != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,307 @@
+package seq
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"reflect"
+	"strconv"
+)
+
+// DecodeJSONArray creates an iterator that decodes the elements of a top-level JSON array read from r.
+//
+// Elements are decoded one by one (using [json.Decoder]), so the array is never loaded into memory as a whole.
+//
+// If reading or decoding fails (or the input is not a JSON array),
+// the iterator yields the error (along with the zero value of V) and stops.
+// Empty or truncated input results in [io.ErrUnexpectedEOF].
+func DecodeJSONArray[V any](r io.Reader) iter.Seq2[V, error] {
+	return func(yield func(V, error) bool) {
+		var zero V
+
+		decoder := json.NewDecoder(r)
+
+		tok, err := decoder.Token()
+		if err != nil {
+			yield(zero, jsonArrayErr(err))
+
+			return
+		}
+
+		if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+			yield(zero, fmt.Errorf("expected JSON array, got %v", tok))
+
+			return
+		}
+
+		for decoder.More() {
+			var v V
+
+			if err := decoder.Decode(&v); err != nil {
+				yield(zero, err)
+
+				return
+			}
+
+			if !yield(v, nil) {
+				return
+			}
+		}
+
+		// Consume the closing bracket to detect truncated input
+		if _, err := decoder.Token(); err != nil {
+			yield(zero, jsonArrayErr(err))
+		}
+	}
+}
+
+// jsonArrayErr turns [io.EOF] into [io.ErrUnexpectedEOF]:
+// the input ending before the array is complete (or even started) is an error,
+// which should not be mistaken for a clean end of input.
+func jsonArrayErr(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+
+	return err
+}
+
+// DecodeJSONLines creates an iterator that decodes a stream of JSON values (JSON Lines or NDJSON) read from r.
+//
+// Values are decoded one by one (using [json.Decoder]).
+// Any whitespace (including newlines) between values is ignored.
+//
+// If reading or decoding fails, the iterator yields the error (along with the zero value of V) and stops.
+func DecodeJSONLines[V any](r io.Reader) iter.Seq2[V, error] {
+	return func(yield func(V, error) bool) {
+		decoder := json.NewDecoder(r)
+
+		for {
+			var v V
+
+			err := decoder.Decode(&v)
+			if errors.Is(err, io.EOF) {
+				return
+			}
+
+			if err != nil {
+				var zero V
+
+				yield(zero, err)
+
+				return
+			}
+
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
+
+// JSONArray is an iterator that encodes as a JSON array.
+//
+// Values are encoded one by one (using [json.Marshal]) as the iterator yields them,
+// so the sequence is never collected into memory.
+// When used with [JSONArray.WriteTo], the output is streamed to the writer.
+//
+// A nil iterator is encoded as null.
+type JSONArray[V any] iter.Seq[V]
+
+// MarshalJSON implements [json.Marshaler].
+func (a JSONArray[V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+
+	if _, err := a.WriteTo(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// WriteTo implements [io.WriterTo].
+//
+// It stops the iteration on the first encoding or write error.
+func (a JSONArray[V]) WriteTo(w io.Writer) (int64, error) {
+	cw := &countingWriter{w: w}
+
+	if a == nil {
+		_, err := io.WriteString(cw, "null")
+
+		return cw.n, err
+	}
+
+	if _, err := io.WriteString(cw, "["); err != nil {
+		return cw.n, err
+	}
+
+	first := true
+
+	for v := range a {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return cw.n, err
+		}
+
+		if !first {
+			if _, err := io.WriteString(cw, ","); err != nil {
+				return cw.n, err
+			}
+		}
+
+		first = false
+
+		if _, err := cw.Write(b); err != nil {
+			return cw.n, err
+		}
+	}
+
+	_, err := io.WriteString(cw, "]")
+
+	return cw.n, err
+}
+
+// JSONObject is an iterator of pairs that encodes as a JSON object.
+//
+// Pairs are encoded one by one as the iterator yields them, in the order they are yielded.
+// Use [Sorted2] to produce deterministic output from a map.
+//
+// Keys are encoded following the same rules as [json.Marshal] does for map keys:
+// they must be strings, integers or implement [encoding.TextMarshaler].
+// Values are encoded using [json.Marshal].
+//
+// Keys are not deduplicated.
+//
+// A nil iterator is encoded as null.
+type JSONObject[K any, V any] iter.Seq2[K, V]
+
+// MarshalJSON implements [json.Marshaler].
+func (o JSONObject[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+
+	if _, err := o.WriteTo(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// WriteTo implements [io.WriterTo].
+//
+// It stops the iteration on the first encoding or write error.
+func (o JSONObject[K, V]) WriteTo(w io.Writer) (int64, error) {
+	cw := &countingWriter{w: w}
+
+	if o == nil {
+		_, err := io.WriteString(cw, "null")
+
+		return cw.n, err
+	}
+
+	if _, err := io.WriteString(cw, "{"); err != nil {
+		return cw.n, err
+	}
+
+	first := true
+
+	for k, v := range o {
+		key, err := marshalJSONKey(k)
+		if err != nil {
+			return cw.n, err
+		}
+
+		value, err := json.Marshal(v)
+		if err != nil {
+			return cw.n, err
+		}
+
+		if !first {
+			if _, err := io.WriteString(cw, ","); err != nil {
+				return cw.n, err
+			}
+		}
+
+		first = false
+
+		if _, err := cw.Write(key); err != nil {
+			return cw.n, err
+		}
+
+		if _, err := io.WriteString(cw, ":"); err != nil {
+			return cw.n, err
+		}
+
+		if _, err := cw.Write(value); err != nil {
+			return cw.n, err
+		}
+	}
+
+	_, err := io.WriteString(cw, "}")
+
+	return cw.n, err
+}
+
+// marshalJSONKey encodes a map key the same way [json.Marshal] does.
+func marshalJSONKey(k any) ([]byte, error) {
+	rv := reflect.ValueOf(k)
+
+	if rv.Kind() == reflect.String {
+		return json.Marshal(rv.String())
+	}
+
+	if tm, ok := k.(encoding.TextMarshaler); ok {
+		if rv.Kind() == reflect.Pointer && rv.IsNil() {
+			return json.Marshal("")
+		}
+
+		text, err := tm.MarshalText()
+		if err != nil {
+			return nil, err
+		}
+
+		return json.Marshal(string(text))
+	}
+
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return json.Marshal(strconv.FormatInt(rv.Int(), 10))
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return json.Marshal(strconv.FormatUint(rv.Uint(), 10))
+	}
+
+	return nil, fmt.Errorf("unsupported JSON object key type: %T", k)
+}
+
+// EncodeJSONLines writes the values yielded by seq to w as JSON Lines (one JSON value per line).
+//
+// Values are encoded one by one (using [json.Encoder]).
+// It stops the iteration and returns the first encoding or write error.
+func EncodeJSONLines[V any](w io.Writer, seq iter.Seq[V]) error {
+	encoder := json.NewEncoder(w)
+
+	for v := range seq {
+		if err := encoder.Encode(v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// countingWriter counts the bytes written to the underlying writer.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.n += int64(n)
+
+	return n, err
+}
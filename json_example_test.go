@@ -0,0 +1,91 @@
+package seq_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/sagikazarmark/seq"
+)
+
+func ExampleDecodeJSONArray() {
+	type user struct {
+		Name string `json:"name"`
+		Role string `json:"role"`
+	}
+
+	r := strings.NewReader(`[{"name": "alice", "role": "admin"}, {"name": "bob", "role": "user"}]`)
+
+	for u, err := range seq.DecodeJSONArray[user](r) {
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Printf("%s: %s\n", u.Name, u.Role)
+	}
+
+	// Output:
+	// alice: admin
+	// bob: user
+}
+
+func ExampleDecodeJSONLines() {
+	r := strings.NewReader("{\"name\": \"alice\"}\n{\"name\": \"bob\"}\n")
+
+	for u, err := range seq.DecodeJSONLines[map[string]string](r) {
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Println(u["name"])
+	}
+
+	// Output:
+	// alice
+	// bob
+}
+
+func ExampleJSONArray() {
+	numbers := seq.Filter(seq.Range(1, 10, 1), func(n int) bool { return n%3 == 0 })
+
+	response := struct {
+		Numbers seq.JSONArray[int] `json:"numbers"`
+	}{
+		Numbers: seq.JSONArray[int](numbers),
+	}
+
+	b, err := json.Marshal(response)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(string(b))
+
+	// Output:
+	// {"numbers":[3,6,9]}
+}
+
+func ExampleJSONObject() {
+	roles := seq.Sorted2(map[string]string{"charlie": "manager", "alice": "admin", "bob": "user"})
+
+	if _, err := seq.JSONObject[string, string](roles).WriteTo(os.Stdout); err != nil {
+		panic(err)
+	}
+
+	// Output:
+	// {"alice":"admin","bob":"user","charlie":"manager"}
+}
+
+func ExampleEncodeJSONLines() {
+	users := slices.Values([]map[string]string{{"name": "alice"}, {"name": "bob"}})
+
+	if err := seq.EncodeJSONLines(os.Stdout, users); err != nil {
+		panic(err)
+	}
+
+	// Output:
+	// {"name":"alice"}
+	// {"name":"bob"}
+}
@@ -0,0 +1,367 @@
+package seq_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/sagikazarmark/seq"
+)
+
+type jsonUser struct {
+	Name string `json:"name"`
+	Age  int    `json:"age"`
+}
+
+func TestDecodeJSONArray(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected []jsonUser
+	}{
+		{"empty_array", `[]`, nil},
+		{"single_element", `[{"name": "alice", "age": 30}]`, []jsonUser{{"alice", 30}}},
+		{"multiple_elements", `[{"name": "alice", "age": 30}, {"name": "bob", "age": 25}]`, []jsonUser{{"alice", 30}, {"bob", 25}}},
+		{"whitespace", "\n  [\n {\"name\": \"alice\"}\n ]\n", []jsonUser{{"alice", 0}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual []jsonUser
+
+			for v, err := range seq.DecodeJSONArray[jsonUser](strings.NewReader(tc.input)) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				actual = append(actual, v)
+			}
+
+			if !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestDecodeJSONArray_Error(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected []int
+	}{
+		{"empty_input", ``, nil},
+		{"whitespace_only", "  \n ", nil},
+		{"not_an_array", `{"a": 1}`, nil},
+		{"scalar", `1`, nil},
+		{"invalid_element", `[1, "two", 3]`, []int{1}},
+		{"truncated", `[1, 2`, []int{1, 2}},
+		{"missing_closing_bracket", `[1, 2 `, []int{1, 2}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				actual []int
+				errs   int
+			)
+
+			for v, err := range seq.DecodeJSONArray[int](strings.NewReader(tc.input)) {
+				if err != nil {
+					errs++
+
+					// io.EOF usually signals a clean end of input
+					if errors.Is(err, io.EOF) {
+						t.Errorf("expected error not to be io.EOF, got %v", err)
+					}
+
+					continue
+				}
+
+				actual = append(actual, v)
+			}
+
+			if !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+
+			if errs != 1 {
+				t.Errorf("expected exactly one error, got %d", errs)
+			}
+		})
+	}
+}
+
+func TestDecodeJSONArray_StopEarly(t *testing.T) {
+	var actual []int
+
+	for v, err := range seq.DecodeJSONArray[int](strings.NewReader(`[1, 2, 3, invalid`)) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		actual = append(actual, v)
+
+		if len(actual) == 2 {
+			break
+		}
+	}
+
+	if expected := []int{1, 2}; !slices.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+func TestDecodeJSONLines(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected []jsonUser
+	}{
+		{"empty_input", ``, nil},
+		{"whitespace_only", "  \n ", nil},
+		{"single_line", `{"name": "alice", "age": 30}`, []jsonUser{{"alice", 30}}},
+		{"multiple_lines", "{\"name\": \"alice\", \"age\": 30}\n{\"name\": \"bob\", \"age\": 25}\n", []jsonUser{{"alice", 30}, {"bob", 25}}},
+		{"blank_lines", "\n{\"name\": \"alice\"}\n\n{\"name\": \"bob\"}\n\n", []jsonUser{{"alice", 0}, {"bob", 0}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual []jsonUser
+
+			for v, err := range seq.DecodeJSONLines[jsonUser](strings.NewReader(tc.input)) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				actual = append(actual, v)
+			}
+
+			if !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestDecodeJSONLines_Error(t *testing.T) {
+	var (
+		actual []int
+		errs   int
+	)
+
+	for v, err := range seq.DecodeJSONLines[int](strings.NewReader("1\n2\n{invalid\n4\n")) {
+		if err != nil {
+			errs++
+
+			continue
+		}
+
+		actual = append(actual, v)
+	}
+
+	if expected := []int{1, 2}; !slices.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+
+	if errs != 1 {
+		t.Errorf("expected exactly one error, got %d", errs)
+	}
+}
+
+func TestJSONArray(t *testing.T) {
+	testCases := []struct {
+		name     string
+		seq      seq.JSONArray[int]
+		expected string
+	}{
+		{"nil_sequence", nil, `null`},
+		{"empty_sequence", seq.JSONArray[int](slices.Values([]int{})), `[]`},
+		{"single_element", seq.JSONArray[int](slices.Values([]int{1})), `[1]`},
+		{"multiple_elements", seq.JSONArray[int](slices.Values([]int{1, 2, 3})), `[1,2,3]`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := json.Marshal(tc.seq)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if string(actual) != tc.expected {
+				t.Errorf("expected %s, got %s", tc.expected, actual)
+			}
+
+			var buf bytes.Buffer
+
+			n, err := tc.seq.WriteTo(&buf)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if buf.String() != tc.expected {
+				t.Errorf("expected %s, got %s", tc.expected, buf.String())
+			}
+
+			if n != int64(buf.Len()) {
+				t.Errorf("expected %d bytes written, got %d", buf.Len(), n)
+			}
+		})
+	}
+}
+
+func TestJSONArray_Nested(t *testing.T) {
+	response := struct {
+		Users seq.JSONArray[jsonUser] `json:"users"`
+	}{
+		Users: seq.JSONArray[jsonUser](slices.Values([]jsonUser{{"alice", 30}, {"bob", 25}})),
+	}
+
+	actual, err := json.Marshal(response)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `{"users":[{"name":"alice","age":30},{"name":"bob","age":25}]}`
+
+	if string(actual) != expected {
+		t.Errorf("expected %s, got %s", expected, actual)
+	}
+}
+
+func TestJSONArray_Error(t *testing.T) {
+	var stopped bool
+
+	values := func(yield func(any) bool) {
+		defer func() { stopped = true }()
+
+		for _, v := range []any{1, make(chan int), 3} {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+
+	_, err := json.Marshal(seq.JSONArray[any](values))
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if !stopped {
+		t.Error("expected the iteration to stop")
+	}
+}
+
+type jsonKey struct {
+	a, b string
+}
+
+func (k jsonKey) MarshalText() ([]byte, error) {
+	return []byte(k.a + "-" + k.b), nil
+}
+
+func TestJSONObject(t *testing.T) {
+	testCases := []struct {
+		name     string
+		seq      json.Marshaler
+		expected string
+	}{
+		{"nil_sequence", seq.JSONObject[string, int](nil), `null`},
+		{"empty_sequence", seq.JSONObject[string, int](seq.Sorted2(map[string]int{})), `{}`},
+		{"string_keys", seq.JSONObject[string, int](seq.Sorted2(map[string]int{"b": 2, "a": 1, "c": 3})), `{"a":1,"b":2,"c":3}`},
+		{"int_keys", seq.JSONObject[int, string](seq.Sorted2(map[int]string{2: "b", -1: "a"})), `{"-1":"a","2":"b"}`},
+		{"uint_keys", seq.JSONObject[uint8, bool](seq.Sorted2(map[uint8]bool{1: true})), `{"1":true}`},
+		{"text_marshaler_keys", seq.JSONObject[jsonKey, int](maps.All(map[jsonKey]int{{"a", "b"}: 1})), `{"a-b":1}`},
+		{"escaped_keys", seq.JSONObject[string, int](maps.All(map[string]int{`"quoted"`: 1})), `{"\"quoted\"":1}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := json.Marshal(tc.seq)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if string(actual) != tc.expected {
+				t.Errorf("expected %s, got %s", tc.expected, actual)
+			}
+
+			var buf bytes.Buffer
+
+			if _, err := tc.seq.(io.WriterTo).WriteTo(&buf); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if buf.String() != tc.expected {
+				t.Errorf("expected %s, got %s", tc.expected, buf.String())
+			}
+		})
+	}
+}
+
+func TestJSONObject_UnsupportedKey(t *testing.T) {
+	_, err := json.Marshal(seq.JSONObject[float64, int](maps.All(map[float64]int{1.5: 1})))
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestEncodeJSONLines(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    []jsonUser
+		expected string
+	}{
+		{"empty_sequence", []jsonUser{}, ""},
+		{"single_element", []jsonUser{{"alice", 30}}, "{\"name\":\"alice\",\"age\":30}\n"},
+		{"multiple_elements", []jsonUser{{"alice", 30}, {"bob", 25}}, "{\"name\":\"alice\",\"age\":30}\n{\"name\":\"bob\",\"age\":25}\n"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			if err := seq.EncodeJSONLines(&buf, slices.Values(tc.input)); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if buf.String() != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, buf.String())
+			}
+		})
+	}
+}
+
+func TestEncodeJSONLines_WriteError(t *testing.T) {
+	writeErr := errors.New("write error")
+
+	var count int
+
+	values := seq.Map(seq.Repeat(1), func(v int) int {
+		count++
+
+		return v
+	})
+
+	err := seq.EncodeJSONLines(errWriter{writeErr}, values)
+	if !errors.Is(err, writeErr) {
+		t.Fatalf("expected %v, got %v", writeErr, err)
+	}
+
+	if count != 1 {
+		t.Errorf("expected the iteration to stop after the first error, got %d values", count)
+	}
+}
+
+type errWriter struct {
+	err error
+}
+
+func (w errWriter) Write([]byte) (int, error) {
+	return 0, w.err
+}
@@ -0,0 +1,59 @@
+package seq
+
+import (
+	"context"
+	"iter"
+	"log/slog"
+	"time"
+)
+
+// Log creates an iterator that logs the values flowing through it.
+//
+// Every value is logged at the given level along with its (zero-based) index.
+// When the iteration ends, the number of values and the duration of the iteration are logged,
+// along with whether the consumer stopped the iteration early.
+// All records carry the name of the sequence to tell stages of a pipeline apart.
+//
+// If the level is disabled, values are passed through without logging (the check is done once per iteration).
+//
+// If logger is nil, [slog.Default] is used.
+func Log[V any](seq iter.Seq[V], logger *slog.Logger, level slog.Level, name string) iter.Seq[V] {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	logger = logger.With(slog.String("seq", name))
+
+	return func(yield func(V) bool) {
+		ctx := context.Background()
+
+		// Avoid building log attributes for every value when the level is disabled
+		if !logger.Enabled(ctx, level) {
+			for v := range seq {
+				if !yield(v) {
+					return
+				}
+			}
+
+			return
+		}
+
+		start := time.Now()
+
+		var count int
+
+		for v := range seq {
+			logger.Log(ctx, level, "value", slog.Int("index", count), slog.Any("value", v))
+
+			count++
+
+			if !yield(v) {
+				logger.Log(ctx, level, "sequence stopped early", slog.Int("count", count), slog.Duration("duration", time.Since(start)))
+
+				return
+			}
+		}
+
+		logger.Log(ctx, level, "sequence completed", slog.Int("count", count), slog.Duration("duration", time.Since(start)))
+	}
+}
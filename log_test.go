@@ -0,0 +1,110 @@
+package seq_test
+
+import (
+	"bytes"
+	"log/slog"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/sagikazarmark/seq"
+)
+
+func newTestLogger(buf *bytes.Buffer) *slog.Logger {
+	return slog.New(slog.NewTextHandler(buf, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			// Remove non-deterministic attributes
+			if a.Key == slog.TimeKey || a.Key == "duration" {
+				return slog.Attr{}
+			}
+
+			return a
+		},
+	}))
+}
+
+func TestLog(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    []int
+		takeN    uint
+		expected []string
+	}{
+		{
+			"empty_sequence",
+			[]int{},
+			10,
+			[]string{`level=DEBUG msg="sequence completed" seq=numbers count=0`},
+		},
+		{
+			"completed",
+			[]int{1, 2},
+			10,
+			[]string{
+				`level=DEBUG msg=value seq=numbers index=0 value=1`,
+				`level=DEBUG msg=value seq=numbers index=1 value=2`,
+				`level=DEBUG msg="sequence completed" seq=numbers count=2`,
+			},
+		},
+		{
+			"stopped_early",
+			[]int{1, 2, 3},
+			1,
+			[]string{
+				`level=DEBUG msg=value seq=numbers index=0 value=1`,
+				`level=DEBUG msg="sequence stopped early" seq=numbers count=1`,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			logged := seq.Log(slices.Values(tc.input), newTestLogger(&buf), slog.LevelDebug, "numbers")
+
+			actual := slices.Collect(seq.Take(logged, tc.takeN))
+
+			if expected := tc.input[:min(len(tc.input), int(tc.takeN))]; !slices.Equal(actual, expected) {
+				t.Errorf("expected %v, got %v", expected, actual)
+			}
+
+			lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+
+			if !slices.Equal(lines, tc.expected) {
+				t.Errorf("expected logs:\n%s\ngot:\n%s", strings.Join(tc.expected, "\n"), strings.Join(lines, "\n"))
+			}
+		})
+	}
+}
+
+func TestLog_Level(t *testing.T) {
+	var buf bytes.Buffer
+
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+
+	actual := slices.Collect(seq.Log(slices.Values([]int{1, 2}), logger, slog.LevelDebug, "numbers"))
+
+	if expected := []int{1, 2}; !slices.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+
+	if buf.Len() != 0 {
+		t.Errorf("expected no logs below the configured level, got %s", buf.String())
+	}
+
+	// Disabled levels should not allocate per value
+	allocs := func(n int) float64 {
+		logged := seq.Log(seq.RepeatN(1, uint(n)), logger, slog.LevelDebug, "numbers")
+
+		return testing.AllocsPerRun(10, func() {
+			for range logged {
+			}
+		})
+	}
+
+	if one, many := allocs(1), allocs(100); many > one {
+		t.Errorf("expected allocations not to depend on the number of values, got %v for 1 value and %v for 100 values", one, many)
+	}
+}
@@ -0,0 +1,196 @@
+package seq
+
+import (
+	"expvar"
+	"iter"
+	"sync"
+	"time"
+)
+
+// Recorder records metrics of instrumented pipeline stages (see [Instrument]).
+//
+// Implementations must be safe for concurrent use.
+type Recorder interface {
+	// Yielded is called every time a stage yields a value.
+	//
+	// Upstream is the time spent waiting for the value
+	// (since the start of the iteration or since the consumer finished processing the previous value).
+	// Downstream is the time spent by the consumer processing the value.
+	Yielded(stage string, upstream time.Duration, downstream time.Duration)
+
+	// Dropped is called every time a stage drops a value (see [InstrumentFilter]).
+	Dropped(stage string)
+
+	// Done is called when the iteration of a stage ends.
+	//
+	// Early reports whether the consumer stopped the iteration before the stage was exhausted.
+	Done(stage string, early bool, duration time.Duration)
+}
+
+// InstrumentOption configures [Instrument] and [InstrumentFilter].
+type InstrumentOption func(*instrumentConfig)
+
+type instrumentConfig struct {
+	clock Clock
+}
+
+// WithInstrumentClock sets the clock used to measure the time spent upstream and downstream.
+//
+// Defaults to [SystemClock].
+func WithInstrumentClock(clock Clock) InstrumentOption {
+	return func(c *instrumentConfig) {
+		c.clock = clock
+	}
+}
+
+// Instrument creates an iterator that records metrics about the values flowing through it.
+//
+// Wrap multiple stages of a pipeline to find the bottleneck:
+// the upstream time of a stage includes the time spent in all stages before it,
+// the downstream time includes the time spent in all stages after it.
+//
+// If recorder is nil, no metrics are recorded.
+func Instrument[V any](seq iter.Seq[V], name string, recorder Recorder, opts ...InstrumentOption) iter.Seq[V] {
+	var config instrumentConfig
+
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	clock := clockOrDefault(config.clock)
+
+	if recorder == nil {
+		recorder = noopRecorder{}
+	}
+
+	return func(yield func(V) bool) {
+		start := clock.Now()
+
+		// ready is the time the consumer finished processing the previous value
+		ready := start
+
+		for v := range seq {
+			received := clock.Now()
+			upstream := received.Sub(ready)
+
+			ok := yield(v)
+
+			ready = clock.Now()
+
+			recorder.Yielded(name, upstream, ready.Sub(received))
+
+			if !ok {
+				recorder.Done(name, true, clock.Now().Sub(start))
+
+				return
+			}
+		}
+
+		recorder.Done(name, false, clock.Now().Sub(start))
+	}
+}
+
+// InstrumentFilter creates an iterator that yields values for which the predicate returns true (see [Filter])
+// and records metrics about the values flowing through it (see [Instrument]).
+//
+// Values rejected by the predicate are recorded as dropped.
+// The time spent in the predicate counts as upstream time.
+//
+// If recorder is nil, no metrics are recorded.
+func InstrumentFilter[V any](seq iter.Seq[V], name string, predicate func(V) bool, recorder Recorder, opts ...InstrumentOption) iter.Seq[V] {
+	if recorder == nil {
+		recorder = noopRecorder{}
+	}
+
+	filtered := func(yield func(V) bool) {
+		for v := range seq {
+			if !predicate(v) {
+				recorder.Dropped(name)
+
+				continue
+			}
+
+			if !yield(v) {
+				return
+			}
+		}
+	}
+
+	return Instrument(filtered, name, recorder, opts...)
+}
+
+// noopRecorder is a [Recorder] that discards metrics.
+type noopRecorder struct{}
+
+func (noopRecorder) Yielded(string, time.Duration, time.Duration) {}
+func (noopRecorder) Dropped(string)                               {}
+func (noopRecorder) Done(string, bool, time.Duration)             {}
+
+// ExpvarRecorder is a [Recorder] that publishes metrics using the [expvar] package.
+//
+// Metrics are published as a map (named after the recorder) of stage maps with the following counters:
+//
+//   - yielded: number of yielded values
+//   - dropped: number of dropped values
+//   - upstream_ns: total time spent waiting for values (in nanoseconds)
+//   - downstream_ns: total time spent processing values (in nanoseconds)
+//   - completed: number of iterations that exhausted the stage
+//   - stopped_early: number of iterations stopped by the consumer
+//   - duration_ns: total duration of iterations (in nanoseconds)
+type ExpvarRecorder struct {
+	vars *expvar.Map
+
+	mu     sync.Mutex
+	stages map[string]*expvar.Map
+}
+
+// NewExpvarRecorder returns a new [ExpvarRecorder] publishing metrics under name.
+//
+// Like [expvar.Publish], it panics if name is already in use.
+func NewExpvarRecorder(name string) *ExpvarRecorder {
+	return &ExpvarRecorder{
+		vars:   expvar.NewMap(name),
+		stages: make(map[string]*expvar.Map),
+	}
+}
+
+func (r *ExpvarRecorder) stage(name string) *expvar.Map {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stage, ok := r.stages[name]
+	if !ok {
+		stage = new(expvar.Map)
+		r.stages[name] = stage
+		r.vars.Set(name, stage)
+	}
+
+	return stage
+}
+
+// Yielded implements [Recorder].
+func (r *ExpvarRecorder) Yielded(stage string, upstream time.Duration, downstream time.Duration) {
+	vars := r.stage(stage)
+
+	vars.Add("yielded", 1)
+	vars.Add("upstream_ns", int64(upstream))
+	vars.Add("downstream_ns", int64(downstream))
+}
+
+// Dropped implements [Recorder].
+func (r *ExpvarRecorder) Dropped(stage string) {
+	r.stage(stage).Add("dropped", 1)
+}
+
+// Done implements [Recorder].
+func (r *ExpvarRecorder) Done(stage string, early bool, duration time.Duration) {
+	vars := r.stage(stage)
+
+	if early {
+		vars.Add("stopped_early", 1)
+	} else {
+		vars.Add("completed", 1)
+	}
+
+	vars.Add("duration_ns", int64(duration))
+}
@@ -0,0 +1,252 @@
+package seq_test
+
+import (
+	"expvar"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sagikazarmark/seq"
+)
+
+type testRecorder struct {
+	mu     sync.Mutex
+	events []string
+
+	upstream   time.Duration
+	downstream time.Duration
+	duration   time.Duration
+}
+
+func (r *testRecorder) Yielded(stage string, upstream time.Duration, downstream time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events = append(r.events, stage+": yielded")
+	r.upstream += upstream
+	r.downstream += downstream
+}
+
+func (r *testRecorder) Dropped(stage string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events = append(r.events, stage+": dropped")
+}
+
+func (r *testRecorder) Done(stage string, early bool, duration time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.duration += duration
+
+	r.events = append(r.events, fmt.Sprintf("%s: done (early: %t)", stage, early))
+}
+
+func TestInstrument(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    []int
+		takeN    uint
+		expected []string
+	}{
+		{
+			"empty_sequence",
+			[]int{},
+			10,
+			[]string{"numbers: done (early: false)"},
+		},
+		{
+			"completed",
+			[]int{1, 2},
+			10,
+			[]string{"numbers: yielded", "numbers: yielded", "numbers: done (early: false)"},
+		},
+		{
+			"stopped_early",
+			[]int{1, 2, 3},
+			1,
+			[]string{"numbers: yielded", "numbers: done (early: true)"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := &testRecorder{}
+
+			instrumented := seq.Instrument(slices.Values(tc.input), "numbers", recorder)
+
+			actual := slices.Collect(seq.Take(instrumented, tc.takeN))
+
+			if expected := tc.input[:min(len(tc.input), int(tc.takeN))]; !slices.Equal(actual, expected) {
+				t.Errorf("expected %v, got %v", expected, actual)
+			}
+
+			if !slices.Equal(recorder.events, tc.expected) {
+				t.Errorf("expected events %q, got %q", tc.expected, recorder.events)
+			}
+		})
+	}
+}
+
+func TestInstrument_Latency(t *testing.T) {
+	testCases := []struct {
+		name               string
+		input              []timedValue
+		processing         time.Duration
+		expectedUpstream   time.Duration
+		expectedDownstream time.Duration
+	}{
+		{
+			"upstream",
+			[]timedValue{{5 * time.Second, 1}, {3 * time.Second, 2}},
+			0,
+			8 * time.Second,
+			0,
+		},
+		{
+			"downstream",
+			[]timedValue{{0, 1}, {0, 2}},
+			2 * time.Second,
+			0,
+			4 * time.Second,
+		},
+		{
+			"both",
+			[]timedValue{{time.Second, 1}, {time.Second, 2}},
+			3 * time.Second,
+			2 * time.Second,
+			6 * time.Second,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clock := newFakeClock()
+			recorder := &testRecorder{}
+
+			for range seq.Instrument(timedSeq(clock, tc.input...), "timed", recorder, seq.WithInstrumentClock(clock)) {
+				clock.Advance(tc.processing)
+			}
+
+			if recorder.upstream != tc.expectedUpstream {
+				t.Errorf("expected upstream time %v, got %v", tc.expectedUpstream, recorder.upstream)
+			}
+
+			if recorder.downstream != tc.expectedDownstream {
+				t.Errorf("expected downstream time %v, got %v", tc.expectedDownstream, recorder.downstream)
+			}
+
+			if expected := tc.expectedUpstream + tc.expectedDownstream; recorder.duration != expected {
+				t.Errorf("expected duration %v, got %v", expected, recorder.duration)
+			}
+		})
+	}
+}
+
+func TestInstrumentFilter(t *testing.T) {
+	recorder := &testRecorder{}
+
+	isEven := func(n int) bool { return n%2 == 0 }
+
+	filtered := seq.InstrumentFilter(slices.Values([]int{1, 2, 3, 4, 5, 6}), "even", isEven, recorder)
+
+	actual := slices.Collect(seq.Take(filtered, 2))
+
+	if expected := []int{2, 4}; !slices.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+
+	expected := []string{
+		"even: dropped",
+		"even: yielded",
+		"even: dropped",
+		"even: yielded",
+		"even: done (early: true)",
+	}
+
+	if !slices.Equal(recorder.events, expected) {
+		t.Errorf("expected events %q, got %q", expected, recorder.events)
+	}
+}
+
+func TestInstrument_NilRecorder(t *testing.T) {
+	isEven := func(n int) bool { return n%2 == 0 }
+
+	instrumented := seq.InstrumentFilter(seq.Instrument(slices.Values([]int{1, 2, 3, 4}), "numbers", nil), "even", isEven, nil)
+
+	if actual, expected := slices.Collect(instrumented), []int{2, 4}; !slices.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+// expvarRecorders is used to generate unique names for expvar recorders
+// (since expvar names cannot be reused, e.g. when tests run multiple times).
+var expvarRecorders atomic.Int64
+
+func TestExpvarRecorder(t *testing.T) {
+	name := fmt.Sprintf("seq_test_expvar_recorder_%d", expvarRecorders.Add(1))
+
+	recorder := seq.NewExpvarRecorder(name)
+
+	isEven := func(n int) bool { return n%2 == 0 }
+
+	source := seq.Instrument(slices.Values([]int{1, 2, 3, 4, 5, 6}), "source", recorder)
+	filtered := seq.InstrumentFilter(source, "even", isEven, recorder)
+
+	for range 2 {
+		for range seq.Take(filtered, 2) {
+		}
+	}
+
+	for range filtered {
+	}
+
+	vars, ok := expvar.Get(name).(*expvar.Map)
+	if !ok {
+		t.Fatal("expected recorder to be published")
+	}
+
+	expected := map[string]map[string]int64{
+		"source": {
+			"yielded":       4 + 4 + 6,
+			"stopped_early": 2,
+			"completed":     1,
+		},
+		"even": {
+			"yielded":       2 + 2 + 3,
+			"dropped":       2 + 2 + 3,
+			"stopped_early": 2,
+			"completed":     1,
+		},
+	}
+
+	for stage, counters := range expected {
+		stageVars, ok := vars.Get(stage).(*expvar.Map)
+		if !ok {
+			t.Fatalf("expected stage %q to be published", stage)
+		}
+
+		for counter, value := range counters {
+			actual, ok := stageVars.Get(counter).(*expvar.Int)
+			if !ok {
+				t.Errorf("expected counter %q of stage %q to be published", counter, stage)
+
+				continue
+			}
+
+			if actual.Value() != value {
+				t.Errorf("expected %s.%s to be %d, got %d", stage, counter, value, actual.Value())
+			}
+		}
+
+		for _, counter := range []string{"upstream_ns", "downstream_ns", "duration_ns"} {
+			if stageVars.Get(counter) == nil {
+				t.Errorf("expected counter %q of stage %q to be published", counter, stage)
+			}
+		}
+	}
+}
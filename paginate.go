@@ -0,0 +1,152 @@
+package seq
+
+import (
+	"context"
+	"iter"
+)
+
+// PaginateOption configures [Paginate].
+type PaginateOption func(*paginateConfig)
+
+type paginateConfig struct {
+	prefetch bool
+}
+
+// WithPrefetch enables fetching the next page in the background while the current page is being consumed.
+//
+// At most one page is fetched ahead.
+func WithPrefetch() PaginateOption {
+	return func(c *paginateConfig) {
+		c.prefetch = true
+	}
+}
+
+// Paginate creates an iterator over the items of a paginated (e.g. cursor-based) source.
+//
+// The fetch function is called with the token of the page to fetch (the zero value for the first page).
+// It returns the items of the page and the token of the next page.
+// The iteration stops when the returned token is the zero value.
+//
+// Pages are fetched lazily: the next page is only fetched when the consumer needs it
+// (or ahead of time if prefetching is enabled using [WithPrefetch]).
+// This makes it safe to combine with [Take] or [TakeWhile] without fetching more pages than required.
+//
+// When prefetching is enabled, the context passed to fetch is canceled when the iteration stops
+// and the iterator waits for the background fetch to return.
+//
+// If fetch fails or ctx is canceled, the iterator yields the error (along with the zero value of V) and stops.
+func Paginate[V any, T comparable](ctx context.Context, fetch func(ctx context.Context, token T) ([]V, T, error), opts ...PaginateOption) iter.Seq2[V, error] {
+	var config paginateConfig
+
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return func(yield func(V, error) bool) {
+		if config.prefetch {
+			paginatePrefetch(ctx, fetch, yield)
+
+			return
+		}
+
+		var (
+			zero  V
+			empty T
+			token T
+		)
+
+		for {
+			page, next, err := fetchPage(ctx, fetch, token)
+			if err != nil {
+				yield(zero, err)
+
+				return
+			}
+
+			for _, v := range page {
+				if !yield(v, nil) {
+					return
+				}
+			}
+
+			if next == empty {
+				return
+			}
+
+			token = next
+		}
+	}
+}
+
+func paginatePrefetch[V any, T comparable](ctx context.Context, fetch func(context.Context, T) ([]V, T, error), yield func(V, error) bool) {
+	type result struct {
+		page []V
+		next T
+		err  error
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	fetchAsync := func(token T) <-chan result {
+		ch := make(chan result, 1)
+
+		go func() {
+			page, next, err := fetchPage(ctx, fetch, token)
+
+			ch <- result{page: page, next: next, err: err}
+		}()
+
+		return ch
+	}
+
+	var (
+		zero  V
+		empty T
+	)
+
+	pending := fetchAsync(empty)
+
+	defer func() {
+		cancel()
+
+		// Wait for the background fetch to finish
+		if pending != nil {
+			<-pending
+		}
+	}()
+
+	for {
+		res := <-pending
+		pending = nil
+
+		if res.err != nil {
+			yield(zero, res.err)
+
+			return
+		}
+
+		if res.next != empty {
+			pending = fetchAsync(res.next)
+		}
+
+		for _, v := range res.page {
+			if !yield(v, nil) {
+				return
+			}
+		}
+
+		if pending == nil {
+			return
+		}
+	}
+}
+
+func fetchPage[V any, T any](ctx context.Context, fetch func(context.Context, T) ([]V, T, error), token T) ([]V, T, error) {
+	if err := ctx.Err(); err != nil {
+		var empty T
+
+		return nil, empty, err
+	}
+
+	return fetch(ctx, token)
+}
@@ -0,0 +1,44 @@
+package seq_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sagikazarmark/seq"
+)
+
+func ExamplePaginate() {
+	pages := map[string][]string{
+		"":      {"alice", "bob"},
+		"page2": {"charlie", "dave"},
+		"page3": {"eve"},
+	}
+
+	nextTokens := map[string]string{
+		"":      "page2",
+		"page2": "page3",
+	}
+
+	fetch := func(ctx context.Context, token string) ([]string, string, error) {
+		fmt.Printf("fetching page %q\n", token)
+
+		return pages[token], nextTokens[token], nil
+	}
+
+	users := seq.Paginate(context.Background(), fetch)
+
+	for user, err := range seq.Take2(users, 3) {
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Println(user)
+	}
+
+	// Output:
+	// fetching page ""
+	// alice
+	// bob
+	// fetching page "page2"
+	// charlie
+}
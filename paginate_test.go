@@ -0,0 +1,231 @@
+package seq_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/sagikazarmark/seq"
+	"github.com/sagikazarmark/seq/seqtest"
+)
+
+// fakePages is a paginated source where tokens are page indices (starting with an empty token).
+type fakePages struct {
+	mu      sync.Mutex
+	pages   [][]int
+	err     error // returned instead of the last page
+	fetched []string
+}
+
+func (p *fakePages) fetch(ctx context.Context, token string) ([]int, string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.fetched = append(p.fetched, token)
+
+	i := 0
+	if token != "" {
+		i, _ = strconv.Atoi(token)
+	}
+
+	if p.err != nil && i == len(p.pages)-1 {
+		return nil, "", p.err
+	}
+
+	next := ""
+	if i+1 < len(p.pages) {
+		next = strconv.Itoa(i + 1)
+	}
+
+	return p.pages[i], next, nil
+}
+
+func (p *fakePages) fetchedPages() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return slices.Clone(p.fetched)
+}
+
+func TestPaginate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		pages    [][]int
+		takeN    uint
+		expected []int
+		fetched  []string
+	}{
+		{"single_page", [][]int{{1, 2}}, 10, []int{1, 2}, []string{""}},
+		{"multiple_pages", [][]int{{1, 2}, {3}, {4, 5}}, 10, []int{1, 2, 3, 4, 5}, []string{"", "1", "2"}},
+		{"empty_page", [][]int{{1}, {}, {2}}, 10, []int{1, 2}, []string{"", "1", "2"}},
+		{"take_from_first_page", [][]int{{1, 2}, {3}, {4, 5}}, 2, []int{1, 2}, []string{""}},
+		{"take_from_second_page", [][]int{{1, 2}, {3}, {4, 5}}, 3, []int{1, 2, 3}, []string{"", "1"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pages := &fakePages{pages: tc.pages}
+
+			var actual []int
+
+			for v, err := range seq.Paginate(context.Background(), pages.fetch) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				actual = append(actual, v)
+
+				if uint(len(actual)) == tc.takeN {
+					break
+				}
+			}
+
+			if !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+
+			if fetched := pages.fetchedPages(); !slices.Equal(fetched, tc.fetched) {
+				t.Errorf("expected pages %q to be fetched, got %q", tc.fetched, fetched)
+			}
+		})
+	}
+}
+
+func TestPaginate_Prefetch(t *testing.T) {
+	testCases := []struct {
+		name     string
+		pages    [][]int
+		takeN    uint
+		expected []int
+		fetched  []string
+		required int // number of pages that must be fetched (the rest might be canceled before fetching)
+	}{
+		{"single_page", [][]int{{1, 2}}, 10, []int{1, 2}, []string{""}, 1},
+		{"multiple_pages", [][]int{{1, 2}, {3}, {4, 5}}, 10, []int{1, 2, 3, 4, 5}, []string{"", "1", "2"}, 3},
+		{"take_from_first_page", [][]int{{1, 2}, {3}, {4, 5}}, 2, []int{1, 2}, []string{"", "1"}, 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pages := &fakePages{pages: tc.pages}
+
+			var actual []int
+
+			// The background fetch must not outlive the iteration (even if it is stopped early)
+			seqtest.AssertNoLeak(t, func() {
+				for v, err := range seq.Paginate(context.Background(), pages.fetch, seq.WithPrefetch()) {
+					if err != nil {
+						t.Errorf("unexpected error: %v", err)
+
+						return
+					}
+
+					actual = append(actual, v)
+
+					if uint(len(actual)) == tc.takeN {
+						break
+					}
+				}
+			})
+
+			if !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+
+			// The iterator waits for the background fetch, so the list of fetched pages is final at this point
+			fetched := pages.fetchedPages()
+			if len(fetched) < tc.required || len(fetched) > len(tc.fetched) || !slices.Equal(fetched, tc.fetched[:len(fetched)]) {
+				t.Errorf("expected pages %q to be fetched, got %q", tc.fetched, fetched)
+			}
+		})
+	}
+}
+
+func TestPaginate_Error(t *testing.T) {
+	fetchErr := errors.New("fetch failed")
+
+	for _, prefetch := range []bool{false, true} {
+		t.Run("prefetch_"+strconv.FormatBool(prefetch), func(t *testing.T) {
+			pages := &fakePages{pages: [][]int{{1, 2}, {3}, nil}, err: fetchErr}
+
+			var opts []seq.PaginateOption
+			if prefetch {
+				opts = append(opts, seq.WithPrefetch())
+			}
+
+			var (
+				actual []int
+				errs   []error
+			)
+
+			for v, err := range seq.Paginate(context.Background(), pages.fetch, opts...) {
+				if err != nil {
+					errs = append(errs, err)
+
+					continue
+				}
+
+				actual = append(actual, v)
+			}
+
+			if expected := []int{1, 2, 3}; !slices.Equal(actual, expected) {
+				t.Errorf("expected %v, got %v", expected, actual)
+			}
+
+			if len(errs) != 1 || !errors.Is(errs[0], fetchErr) {
+				t.Errorf("expected %v, got %v", fetchErr, errs)
+			}
+		})
+	}
+}
+
+func TestPaginate_ContextCanceled(t *testing.T) {
+	for _, prefetch := range []bool{false, true} {
+		t.Run("prefetch_"+strconv.FormatBool(prefetch), func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			pages := &fakePages{pages: [][]int{{1, 2}, {3}}}
+
+			fetch := func(ctx context.Context, token string) ([]int, string, error) {
+				page, next, err := pages.fetch(ctx, token)
+
+				// Cancel the context once the first page is fetched
+				cancel()
+
+				return page, next, err
+			}
+
+			var opts []seq.PaginateOption
+			if prefetch {
+				opts = append(opts, seq.WithPrefetch())
+			}
+
+			var (
+				actual []int
+				errs   []error
+			)
+
+			for v, err := range seq.Paginate(ctx, fetch, opts...) {
+				if err != nil {
+					errs = append(errs, err)
+
+					continue
+				}
+
+				actual = append(actual, v)
+			}
+
+			if expected := []int{1, 2}; !slices.Equal(actual, expected) {
+				t.Errorf("expected %v, got %v", expected, actual)
+			}
+
+			if len(errs) != 1 || !errors.Is(errs[0], context.Canceled) {
+				t.Errorf("expected %v, got %v", context.Canceled, errs)
+			}
+		})
+	}
+}
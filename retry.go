@@ -0,0 +1,129 @@
+package seq
+
+import (
+	"iter"
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// RetryPolicy configures [Retry].
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of consecutive retries without yielding a value.
+	// The counter is reset every time the source yields a value.
+	MaxRetries uint
+
+	// InitialBackoff is the time to wait before the first retry.
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the time to wait between retries (if positive).
+	MaxBackoff time.Duration
+
+	// Multiplier is the factor the backoff grows by after each consecutive retry.
+	// Defaults to 2 if it is less than 1.
+	Multiplier float64
+
+	// Jitter is the fraction of the backoff (between 0 and 1) that is randomized.
+	// For example, with a jitter of 0.2 the actual backoff is between 80% and 100% of the calculated backoff.
+	Jitter float64
+
+	// Retryable reports whether an error should be retried.
+	// If nil, all errors are retried.
+	Retryable func(error) bool
+
+	// Clock is used to wait between retries.
+	// If nil, [SystemClock] is used.
+	Clock Clock
+}
+
+// backoff returns the time to wait before the nth (zero-based) consecutive retry.
+//
+// Without a maximum, the backoff is capped at the maximum representable duration.
+func (p RetryPolicy) backoff(n uint) time.Duration {
+	if p.InitialBackoff <= 0 {
+		return 0
+	}
+
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 2
+	}
+
+	limit := float64(math.MaxInt64)
+	if p.MaxBackoff > 0 {
+		limit = min(limit, float64(p.MaxBackoff))
+	}
+
+	// math.Pow returns +Inf on overflow, which is capped by the limit
+	backoff := min(float64(p.InitialBackoff)*math.Pow(multiplier, float64(n)), limit)
+
+	if jitter := min(max(p.Jitter, 0), 1); jitter > 0 {
+		backoff -= backoff * jitter * rand.Float64()
+	}
+
+	// float64(math.MaxInt64) rounds up to 2^63, which does not fit into a time.Duration
+	if backoff >= float64(math.MaxInt64) {
+		return time.Duration(math.MaxInt64)
+	}
+
+	return time.Duration(backoff)
+}
+
+// Retry creates an iterator over a fallible source that is re-created when it fails.
+//
+// The factory function creates the source.
+// It receives a resume token: the zero value of R the first time,
+// and the token returned by resume for the last yielded value on subsequent calls
+// (or the zero value if no values have been yielded yet).
+// The factory is expected to return a source that continues after the position the token represents,
+// so that values are not yielded twice.
+//
+// When the source yields an error, the iterator waits (with exponential backoff and jitter according to policy)
+// and re-creates the source from the last yielded position.
+// If the error is not retryable or the number of consecutive retries exceeds the maximum,
+// the iterator yields the error (along with the zero value of V) and stops.
+func Retry[V any, R any](factory func(resume R) iter.Seq2[V, error], resume func(V) R, policy RetryPolicy) iter.Seq2[V, error] {
+	clock := clockOrDefault(policy.Clock)
+
+	return func(yield func(V, error) bool) {
+		var (
+			token   R
+			retries uint
+		)
+
+		for {
+			var failure error
+
+			for v, err := range factory(token) {
+				if err != nil {
+					failure = err
+
+					break
+				}
+
+				token = resume(v)
+				retries = 0
+
+				if !yield(v, nil) {
+					return
+				}
+			}
+
+			if failure == nil {
+				return
+			}
+
+			if retries >= policy.MaxRetries || (policy.Retryable != nil && !policy.Retryable(failure)) {
+				var zero V
+
+				yield(zero, failure)
+
+				return
+			}
+
+			<-clock.After(policy.backoff(retries))
+
+			retries++
+		}
+	}
+}
@@ -0,0 +1,236 @@
+package seq_test
+
+import (
+	"errors"
+	"iter"
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/sagikazarmark/seq"
+)
+
+// flakySource yields numbers from the resume position, failing after a number of values on each attempt.
+type flakySource struct {
+	values   []int
+	failures []int // number of values to yield before failing on each attempt (the rest of the attempts succeed)
+	err      error
+	resumes  []int
+}
+
+func (s *flakySource) factory(resume int) iter.Seq2[int, error] {
+	s.resumes = append(s.resumes, resume)
+
+	attempt := len(s.resumes) - 1
+
+	return func(yield func(int, error) bool) {
+		for i, v := range s.values[resume:] {
+			if attempt < len(s.failures) && i == s.failures[attempt] {
+				yield(0, s.err)
+
+				return
+			}
+
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
+
+// resumeAfter returns the position after a value (values are equal to their index).
+func resumeAfter(v int) int {
+	return v + 1
+}
+
+func TestRetry(t *testing.T) {
+	sourceErr := errors.New("connection reset")
+
+	testCases := []struct {
+		name     string
+		failures []int
+		policy   seq.RetryPolicy
+		expected []int
+		resumes  []int
+		waited   []time.Duration
+		err      bool
+	}{
+		{
+			"no_failures",
+			nil,
+			seq.RetryPolicy{MaxRetries: 3, InitialBackoff: time.Second},
+			[]int{0, 1, 2, 3, 4},
+			[]int{0},
+			nil,
+			false,
+		},
+		{
+			"resumes_after_failures",
+			[]int{2, 1},
+			seq.RetryPolicy{MaxRetries: 1, InitialBackoff: time.Second},
+			[]int{0, 1, 2, 3, 4},
+			[]int{0, 2, 3},
+			[]time.Duration{time.Second, time.Second},
+			false,
+		},
+		{
+			"exponential_backoff",
+			[]int{0, 0, 0},
+			seq.RetryPolicy{MaxRetries: 3, InitialBackoff: time.Second, MaxBackoff: 3 * time.Second},
+			[]int{0, 1, 2, 3, 4},
+			[]int{0, 0, 0, 0},
+			[]time.Duration{time.Second, 2 * time.Second, 3 * time.Second},
+			false,
+		},
+		{
+			"custom_multiplier",
+			[]int{0, 0},
+			seq.RetryPolicy{MaxRetries: 3, InitialBackoff: time.Second, Multiplier: 3},
+			[]int{0, 1, 2, 3, 4},
+			[]int{0, 0, 0},
+			[]time.Duration{time.Second, 3 * time.Second},
+			false,
+		},
+		{
+			"max_retries_exceeded",
+			[]int{1, 0, 0},
+			seq.RetryPolicy{MaxRetries: 1, InitialBackoff: time.Second},
+			[]int{0},
+			[]int{0, 1},
+			[]time.Duration{time.Second},
+			true,
+		},
+		{
+			"not_retryable",
+			[]int{1},
+			seq.RetryPolicy{MaxRetries: 3, Retryable: func(error) bool { return false }},
+			[]int{0},
+			[]int{0},
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clock := newFakeClock()
+			tc.policy.Clock = clock
+
+			source := &flakySource{values: []int{0, 1, 2, 3, 4}, failures: tc.failures, err: sourceErr}
+
+			var (
+				actual []int
+				errs   []error
+			)
+
+			for v, err := range seq.Retry(source.factory, resumeAfter, tc.policy) {
+				if err != nil {
+					errs = append(errs, err)
+
+					continue
+				}
+
+				actual = append(actual, v)
+			}
+
+			if !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+
+			if !slices.Equal(source.resumes, tc.resumes) {
+				t.Errorf("expected resume tokens %v, got %v", tc.resumes, source.resumes)
+			}
+
+			if !slices.Equal(clock.waited, tc.waited) {
+				t.Errorf("expected waits %v, got %v", tc.waited, clock.waited)
+			}
+
+			if tc.err && (len(errs) != 1 || !errors.Is(errs[0], sourceErr)) {
+				t.Errorf("expected %v, got %v", sourceErr, errs)
+			} else if !tc.err && len(errs) != 0 {
+				t.Errorf("expected no errors, got %v", errs)
+			}
+		})
+	}
+}
+
+func TestRetry_Jitter(t *testing.T) {
+	clock := newFakeClock()
+
+	source := &flakySource{values: []int{0}, failures: slices.Repeat([]int{0}, 100), err: errors.New("error")}
+
+	policy := seq.RetryPolicy{
+		MaxRetries:     100,
+		InitialBackoff: time.Second,
+		MaxBackoff:     time.Second,
+		Jitter:         0.5,
+		Clock:          clock,
+	}
+
+	for range seq.Retry(source.factory, resumeAfter, policy) {
+	}
+
+	for _, d := range clock.waited {
+		if d < 500*time.Millisecond || d > time.Second {
+			t.Fatalf("expected backoff between 500ms and 1s, got %v", d)
+		}
+	}
+
+	if slices.Min(clock.waited) == slices.Max(clock.waited) {
+		t.Error("expected backoff to be randomized")
+	}
+}
+
+func TestRetry_BackoffOverflow(t *testing.T) {
+	clock := newFakeClock()
+
+	source := &flakySource{values: []int{0}, failures: slices.Repeat([]int{0}, 100), err: errors.New("error")}
+
+	policy := seq.RetryPolicy{
+		MaxRetries:     100,
+		InitialBackoff: time.Second,
+		Clock:          clock,
+	}
+
+	for range seq.Retry(source.factory, resumeAfter, policy) {
+	}
+
+	if len(clock.waited) != 100 {
+		t.Fatalf("expected 100 retries, got %d", len(clock.waited))
+	}
+
+	if !slices.IsSorted(clock.waited) || clock.waited[0] != time.Second {
+		t.Errorf("expected backoff to grow from 1s, got %v", clock.waited)
+	}
+
+	if last, expected := clock.waited[len(clock.waited)-1], time.Duration(math.MaxInt64); last != expected {
+		t.Errorf("expected backoff to be capped at %v, got %v", expected, last)
+	}
+}
+
+func TestRetry_StopEarly(t *testing.T) {
+	source := &flakySource{values: []int{0, 1, 2, 3, 4}, failures: []int{2}, err: errors.New("error")}
+
+	var actual []int
+
+	for v, err := range seq.Retry(source.factory, resumeAfter, seq.RetryPolicy{MaxRetries: 1, Clock: newFakeClock()}) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		actual = append(actual, v)
+
+		if len(actual) == 3 {
+			break
+		}
+	}
+
+	if expected := []int{0, 1, 2}; !slices.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+
+	if expected := []int{0, 2}; !slices.Equal(source.resumes, expected) {
+		t.Errorf("expected resume tokens %v, got %v", expected, source.resumes)
+	}
+}
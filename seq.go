@@ -112,6 +112,25 @@ func FilterMap2[K any, V any, U any](seq iter.Seq2[K, V], fn func(K, V) (U, bool
 	}
 }
 
+// FlatMap creates an iterator that maps each value to an iterator and flattens the results.
+//
+// The returned iterator will yield all values of the iterator returned for the first value,
+// then all values of the iterator returned for the second value, and so on.
+//
+// It is equivalent to calling [FlattenSeq] on the result of [Map],
+// and useful for one-to-many transformations without allocating intermediate slices.
+func FlatMap[V any, U any](seq iter.Seq[V], fn func(V) iter.Seq[U]) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for v := range seq {
+			for u := range fn(v) {
+				if !yield(u) {
+					return
+				}
+			}
+		}
+	}
+}
+
 // Flatten returns an iterator that yields all elements of each slice
 // produced by the outer sequence. It takes a sequence of slices and
 // "flattens" it into a sequence of individual elements.
@@ -133,6 +152,116 @@ func Flatten[V any](seq iter.Seq[[]V]) iter.Seq[V] {
 	}
 }
 
+// Flatten2 returns an iterator that yields all pairs of each iterator
+// produced by the outer sequence.
+//
+// The pairs are yielded in order: first all pairs from the first iterator,
+// then all pairs from the second iterator, and so on.
+func Flatten2[K any, V any](seq iter.Seq[iter.Seq2[K, V]]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for inner := range seq {
+			for k, v := range inner {
+				if !yield(k, v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// FlattenMap returns an iterator that yields all key-value pairs of each map
+// produced by the outer sequence.
+//
+// Maps are yielded in order, but pairs within a single map are yielded in an unspecified order
+// (see [maps.All]).
+func FlattenMap[Map ~map[K]V, K comparable, V any](seq iter.Seq[Map]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for m := range seq {
+			for k, v := range m {
+				if !yield(k, v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// FlattenSeq returns an iterator that yields all values of each iterator
+// produced by the outer sequence.
+//
+// It works like [Flatten], but for nested iterators instead of slices.
+func FlattenSeq[V any](seq iter.Seq[iter.Seq[V]]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for inner := range seq {
+			for v := range inner {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Generate creates an iterator that yields the values returned by calling fn over and over.
+//
+// WARNING: This iterator will never terminate on its own.
+func Generate[V any](fn func() V) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for {
+			if !yield(fn()) {
+				return
+			}
+		}
+	}
+}
+
+// Interleave creates an iterator that alternates between the provided iterators, yielding one value from each in turn.
+//
+// The new iterator stops as soon as any of the iterators is exhausted.
+// See [RoundRobin] for a variant that continues with the remaining iterators.
+func Interleave[V any](seqs ...iter.Seq[V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		if len(seqs) == 0 {
+			return
+		}
+
+		nexts := make([]func() (V, bool), 0, len(seqs))
+
+		for _, seq := range seqs {
+			next, stop := iter.Pull(seq)
+			defer stop()
+
+			nexts = append(nexts, next)
+		}
+
+		for {
+			for _, next := range nexts {
+				v, ok := next()
+				if !ok {
+					return
+				}
+
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Iterate creates an iterator that yields seed, fn(seed), fn(fn(seed)), and so on.
+//
+// WARNING: This iterator will never terminate on its own.
+func Iterate[V any](seed V, fn func(V) V) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for v := seed; ; v = fn(v) {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
 // Map creates an iterator that transforms values using a function.
 //
 // The returned iterator will yield the transformed values.
@@ -159,6 +288,56 @@ func Map2[K any, V any, U any](seq iter.Seq2[K, V], fn func(K, V) U) iter.Seq2[K
 	}
 }
 
+// Number is a constraint for integer and floating-point types.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Range creates an iterator that yields numbers from start (inclusive) to end (exclusive) by step.
+//
+// If step is negative, the iterator counts down from start to end.
+// If step is zero or start is already past end, nothing is yielded.
+//
+// Values are calculated as start + i*step to avoid accumulating floating-point errors.
+// The iterator stops if the next value would overflow.
+func Range[N Number](start N, end N, step N) iter.Seq[N] {
+	return func(yield func(N) bool) {
+		var zero N
+
+		switch {
+		case step > zero:
+			for i, v := N(1), start; v < end; i++ {
+				if !yield(v) {
+					return
+				}
+
+				next := start + i*step
+				if next <= v { // overflow
+					return
+				}
+
+				v = next
+			}
+
+		case step < zero:
+			for i, v := N(1), start; v > end; i++ {
+				if !yield(v) {
+					return
+				}
+
+				next := start + i*step
+				if next >= v { // overflow
+					return
+				}
+
+				v = next
+			}
+		}
+	}
+}
+
 // Repeat creates an iterator that yields the same value over and over.
 //
 // WARNING: This iterator will never terminate on its own.
@@ -172,6 +351,44 @@ func Repeat[V any](v V) iter.Seq[V] {
 	}
 }
 
+// Repeat2 creates an iterator that yields the same pair over and over.
+//
+// WARNING: This iterator will never terminate on its own.
+func Repeat2[K any, V any](k K, v V) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// RepeatN creates an iterator that yields the same value n times.
+func RepeatN[V any](v V, n uint) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for range n {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// RoundRobin creates an iterator that alternates between the provided iterators, yielding one value from each in turn.
+//
+// Exhausted iterators are dropped, the new iterator continues with the remaining ones until all of them are exhausted.
+// See [Interleave] for a variant that stops when any of the iterators is exhausted.
+func RoundRobin[V any](seqs ...iter.Seq[V]) iter.Seq[V] {
+	weighted := make([]WeightedSeq[V], 0, len(seqs))
+
+	for _, seq := range seqs {
+		weighted = append(weighted, WeightedSeq[V]{Seq: seq, Weight: 1})
+	}
+
+	return WeightedRoundRobin(weighted...)
+}
+
 // Skip creates an iterator that skips values until n values are skipped or the end of the iterator is reached (whichever happens first).
 func Skip[V comparable](seq iter.Seq[V], n uint) iter.Seq[V] {
 	// Return early if n is zero
@@ -379,6 +596,60 @@ func TakeWhile2[K comparable, V any](seq iter.Seq2[K, V], predicate func(K, V) b
 	}
 }
 
+// Tap creates an iterator that calls fn for each value before yielding it.
+//
+// The values are not altered, which makes it useful for observing (e.g. logging or debugging) a pipeline.
+func Tap[V any](seq iter.Seq[V], fn func(V)) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for v := range seq {
+			fn(v)
+
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Tap2 creates an iterator that calls fn for each pair before yielding it.
+//
+// The pairs are not altered, which makes it useful for observing (e.g. logging or debugging) a pipeline.
+func Tap2[K any, V any](seq iter.Seq2[K, V], fn func(K, V)) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range seq {
+			fn(k, v)
+
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Unfold creates an iterator from an initial state and a function generating values from it.
+//
+// On each iteration fn is called with the current state.
+// It returns the value to yield, the next state and whether the iteration should continue.
+// If it returns false, the iteration stops without yielding the returned value.
+func Unfold[S any, V any](state S, fn func(S) (V, S, bool)) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		s := state
+
+		for {
+			v, next, ok := fn(s)
+			if !ok {
+				return
+			}
+
+			if !yield(v) {
+				return
+			}
+
+			s = next
+		}
+	}
+}
+
 // Uniq ensures only unique values are returned from a sequence.
 //
 // Items are returned in the order they first appear.
@@ -459,3 +730,69 @@ func ValuesErr[Slice ~[]E, E any](s Slice, err error) (iter.Seq[E], error) {
 
 	return slices.Values(s), nil
 }
+
+// WeightedSeq is an iterator with a weight used by [WeightedRoundRobin].
+type WeightedSeq[V any] struct {
+	Seq iter.Seq[V]
+
+	// Weight is the number of values yielded from Seq in each round.
+	// Iterators with zero weight are never consumed.
+	Weight uint
+}
+
+// WeightedRoundRobin creates an iterator that alternates between the provided iterators,
+// yielding (at most) as many values from each in turn as its weight.
+//
+// For example, given weights 3 and 1, the new iterator yields three values from the first iterator,
+// then one value from the second one, and so on.
+//
+// Exhausted iterators are dropped, the new iterator continues with the remaining ones until all of them are exhausted.
+func WeightedRoundRobin[V any](seqs ...WeightedSeq[V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		type source struct {
+			next   func() (V, bool)
+			weight uint
+		}
+
+		sources := make([]source, 0, len(seqs))
+
+		for _, seq := range seqs {
+			if seq.Weight == 0 {
+				continue
+			}
+
+			next, stop := iter.Pull(seq.Seq)
+			defer stop()
+
+			sources = append(sources, source{next: next, weight: seq.Weight})
+		}
+
+		for len(sources) > 0 {
+			for i := 0; i < len(sources); {
+				src := sources[i]
+				exhausted := false
+
+				for range src.weight {
+					v, ok := src.next()
+					if !ok {
+						exhausted = true
+
+						break
+					}
+
+					if !yield(v) {
+						return
+					}
+				}
+
+				if exhausted {
+					sources = slices.Delete(sources, i, i+1)
+
+					continue
+				}
+
+				i++
+			}
+		}
+	}
+}
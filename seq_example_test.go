@@ -121,6 +121,30 @@ func ExampleFilterMap2() {
 	// dave: 52500
 }
 
+func ExampleFlatMap() {
+	permissions := map[string][]string{
+		"alice": {"read", "write"},
+		"bob":   {"read"},
+	}
+
+	users := slices.Values([]string{"alice", "bob"})
+
+	userPermissions := seq.FlatMap(users, func(user string) iter.Seq[string] {
+		return seq.Map(slices.Values(permissions[user]), func(p string) string {
+			return user + ":" + p
+		})
+	})
+
+	for p := range userPermissions {
+		fmt.Println(p)
+	}
+
+	// Output:
+	// alice:read
+	// alice:write
+	// bob:read
+}
+
 func ExampleFlatten() {
 	job1 := func() []string {
 		return []string{"foo", "bar"}
@@ -151,6 +175,89 @@ func ExampleFlatten() {
 	// quux
 }
 
+func ExampleFlattenMap() {
+	groups := slices.Values([]map[string]string{
+		{"alice": "admin"},
+		{"bob": "user", "charlie": "manager"},
+	})
+
+	users := seq.FlattenMap(groups)
+
+	printSorted(users)
+
+	// Output:
+	// alice: admin
+	// bob: user
+	// charlie: manager
+}
+
+func ExampleFlattenSeq() {
+	nested := slices.Values([]iter.Seq[int]{
+		slices.Values([]int{1, 2}),
+		seq.Take(seq.Repeat(3), 2),
+	})
+
+	for n := range seq.FlattenSeq(nested) {
+		fmt.Println(n)
+	}
+
+	// Output:
+	// 1
+	// 2
+	// 3
+	// 3
+}
+
+func ExampleGenerate() {
+	var id int
+
+	nextID := func() string {
+		id++
+
+		return fmt.Sprintf("id-%d", id)
+	}
+
+	for v := range seq.Take(seq.Generate(nextID), 3) {
+		fmt.Println(v)
+	}
+
+	// Output:
+	// id-1
+	// id-2
+	// id-3
+}
+
+func ExampleInterleave() {
+	letters := slices.Values([]string{"a", "b", "c"})
+	numbers := slices.Values([]string{"1", "2"})
+
+	for v := range seq.Interleave(letters, numbers) {
+		fmt.Println(v)
+	}
+
+	// Output:
+	// a
+	// 1
+	// b
+	// 2
+	// c
+}
+
+func ExampleIterate() {
+	powersOfTwo := seq.Iterate(1, func(n int) int { return n * 2 })
+
+	for n := range seq.Take(powersOfTwo, 5) {
+		fmt.Println(n)
+	}
+
+	// Output:
+	// 1
+	// 2
+	// 4
+	// 8
+	// 16
+}
+
 func ExampleMap() {
 	numbers := slices.Values([]int{1, 2, 3, 4, 5})
 
@@ -192,6 +299,18 @@ func ExampleMap2() {
 	// dave: 1500
 }
 
+func ExampleRange() {
+	for n := range seq.Range(10, 0, -3) {
+		fmt.Println(n)
+	}
+
+	// Output:
+	// 10
+	// 7
+	// 4
+	// 1
+}
+
 func ExampleRepeat() {
 	meaningOfLife := seq.Repeat(42)
 
@@ -213,6 +332,32 @@ func ExampleRepeat() {
 	// 42
 }
 
+func ExampleRepeatN() {
+	for v := range seq.RepeatN("hello", 3) {
+		fmt.Println(v)
+	}
+
+	// Output:
+	// hello
+	// hello
+	// hello
+}
+
+func ExampleRoundRobin() {
+	letters := slices.Values([]string{"a", "b", "c"})
+	numbers := slices.Values([]string{"1"})
+
+	for v := range seq.RoundRobin(letters, numbers) {
+		fmt.Println(v)
+	}
+
+	// Output:
+	// a
+	// 1
+	// b
+	// c
+}
+
 func ExampleSkip() {
 	fruits := slices.Values([]string{"apple", "banana", "cherry", "grape", "mango"})
 
@@ -340,6 +485,42 @@ func ExampleTakeWhile2() {
 	// bob: admin
 }
 
+func ExampleTap() {
+	numbers := slices.Values([]int{1, 2, 3, 4, 5})
+
+	evens := seq.Filter(seq.Tap(numbers, func(n int) {
+		fmt.Println("before filter:", n)
+	}), func(n int) bool { return n%2 == 0 })
+
+	for n := range seq.Take(evens, 1) {
+		fmt.Println("after filter:", n)
+	}
+
+	// Output:
+	// before filter: 1
+	// before filter: 2
+	// after filter: 2
+}
+
+func ExampleUnfold() {
+	fibonacci := seq.Unfold([2]int{0, 1}, func(s [2]int) (int, [2]int, bool) {
+		return s[0], [2]int{s[1], s[0] + s[1]}, true
+	})
+
+	for n := range seq.TakeWhile(fibonacci, func(n int) bool { return n < 10 }) {
+		fmt.Println(n)
+	}
+
+	// Output:
+	// 0
+	// 1
+	// 1
+	// 2
+	// 3
+	// 5
+	// 8
+}
+
 func ExampleUniq() {
 	numbers := slices.Values([]int{1, 2, 2, 3, 1, 4, 3, 5})
 
@@ -407,6 +588,28 @@ func ExampleValuesErr_error() {
 	// something went wrong
 }
 
+func ExampleWeightedRoundRobin() {
+	high := slices.Values([]string{"high1", "high2", "high3", "high4"})
+	low := slices.Values([]string{"low1", "low2"})
+
+	jobs := seq.WeightedRoundRobin(
+		seq.WeightedSeq[string]{Seq: high, Weight: 3},
+		seq.WeightedSeq[string]{Seq: low, Weight: 1},
+	)
+
+	for job := range jobs {
+		fmt.Println(job)
+	}
+
+	// Output:
+	// high1
+	// high2
+	// high3
+	// low1
+	// high4
+	// low2
+}
+
 // Maps are unordered, so we need to hack for examples
 func printSorted[K any, V any](s iter.Seq2[K, V]) {
 	var output []string
@@ -1,12 +1,15 @@
 package seq_test
 
 import (
+	"fmt"
 	"iter"
 	"maps"
+	"math"
 	"slices"
 	"testing"
 
 	"github.com/sagikazarmark/seq"
+	"github.com/sagikazarmark/seq/seqtest"
 )
 
 func TestChain(t *testing.T) {
@@ -319,6 +322,57 @@ func TestFilterMap2(t *testing.T) {
 	}
 }
 
+func TestFlatMap(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    []int
+		fn       func(int) iter.Seq[int]
+		expected []int
+	}{
+		{
+			"empty_sequence",
+			[]int{},
+			func(n int) iter.Seq[int] { return seq.Take(seq.Repeat(n), uint(n)) },
+			[]int{},
+		},
+		{
+			"repeat_numbers",
+			[]int{1, 2, 3},
+			func(n int) iter.Seq[int] { return seq.Take(seq.Repeat(n), uint(n)) },
+			[]int{1, 2, 2, 3, 3, 3},
+		},
+		{
+			"empty_results",
+			[]int{1, 2, 3},
+			func(n int) iter.Seq[int] { return slices.Values([]int{}) },
+			[]int{},
+		},
+		{
+			"empty_results_mixed_with_non_empty",
+			[]int{1, 2, 3},
+			func(n int) iter.Seq[int] {
+				if n == 2 {
+					return slices.Values([]int{})
+				}
+
+				return slices.Values([]int{n, -n})
+			},
+			[]int{1, -1, 3, -3},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			input := slices.Values(tc.input)
+			actual := slices.Collect(seq.FlatMap(input, tc.fn))
+
+			if !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
 func TestFlatten(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -343,6 +397,155 @@ func TestFlatten(t *testing.T) {
 	}
 }
 
+func TestFlatten2(t *testing.T) {
+	testCases := []struct {
+		name     string
+		seq      iter.Seq[iter.Seq2[string, int]]
+		expected map[string]int
+	}{
+		{"empty_sequence", slices.Values([]iter.Seq2[string, int]{}), map[string]int{}},
+		{"single_empty_sequence", slices.Values([]iter.Seq2[string, int]{maps.All(map[string]int{})}), map[string]int{}},
+		{
+			"multiple_sequences",
+			slices.Values([]iter.Seq2[string, int]{maps.All(map[string]int{"a": 1, "b": 2}), maps.All(map[string]int{"c": 3})}),
+			map[string]int{"a": 1, "b": 2, "c": 3},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := maps.Collect(seq.Flatten2(tc.seq))
+			if !maps.Equal(result, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestFlattenMap(t *testing.T) {
+	testCases := []struct {
+		name     string
+		seq      iter.Seq[map[string]int]
+		expected map[string]int
+	}{
+		{"empty_sequence", slices.Values([]map[string]int{}), map[string]int{}},
+		{"single_empty_map", slices.Values([]map[string]int{{}}), map[string]int{}},
+		{"multiple_maps", slices.Values([]map[string]int{{"a": 1, "b": 2}, {}, {"c": 3}}), map[string]int{"a": 1, "b": 2, "c": 3}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := maps.Collect(seq.FlattenMap(tc.seq))
+			if !maps.Equal(result, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestFlattenSeq(t *testing.T) {
+	testCases := []struct {
+		name     string
+		seq      iter.Seq[iter.Seq[int]]
+		expected []int
+	}{
+		{"empty_sequence", slices.Values([]iter.Seq[int]{}), []int{}},
+		{"single_empty_sequence", slices.Values([]iter.Seq[int]{slices.Values([]int{})}), []int{}},
+		{"multiple_sequences", slices.Values([]iter.Seq[int]{slices.Values([]int{1, 2}), slices.Values([]int{3, 4}), slices.Values([]int{5})}), []int{1, 2, 3, 4, 5}},
+		{"empty_sequences_mixed_with_non_empty", slices.Values([]iter.Seq[int]{slices.Values([]int{1}), slices.Values([]int{}), slices.Values([]int{2})}), []int{1, 2}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := slices.Collect(seq.FlattenSeq(tc.seq))
+			if !slices.Equal(result, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	var counter int
+
+	fn := func() int {
+		counter++
+
+		return counter
+	}
+
+	actual := slices.Collect(seq.Take(seq.Generate(fn), 3))
+	expected := []int{1, 2, 3}
+
+	if !slices.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+
+	if counter != 3 {
+		t.Errorf("expected fn to be called 3 times, got %d", counter)
+	}
+}
+
+func TestInterleave(t *testing.T) {
+	testCases := []struct {
+		name     string
+		seqs     []iter.Seq[int]
+		expected []int
+	}{
+		{"no_sequences", []iter.Seq[int]{}, []int{}},
+		{"single_sequence", []iter.Seq[int]{slices.Values([]int{1, 2, 3})}, []int{1, 2, 3}},
+		{"equal_length", []iter.Seq[int]{slices.Values([]int{1, 3, 5}), slices.Values([]int{2, 4, 6})}, []int{1, 2, 3, 4, 5, 6}},
+		{"first_shorter", []iter.Seq[int]{slices.Values([]int{1, 3}), slices.Values([]int{2, 4, 6})}, []int{1, 2, 3, 4}},
+		{"second_shorter", []iter.Seq[int]{slices.Values([]int{1, 3, 5}), slices.Values([]int{2})}, []int{1, 2, 3}},
+		{"empty_sequence_mixed_with_non_empty", []iter.Seq[int]{slices.Values([]int{1}), slices.Values([]int{})}, []int{1}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := slices.Collect(seq.Interleave(tc.seqs...))
+
+			if !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestInterleave_Cleanup(t *testing.T) {
+	tracker := &stopTracker{}
+
+	for range seq.Interleave(tracker.track(seq.Repeat(1)), tracker.track(seq.Repeat(2))) {
+		break
+	}
+
+	tracker.assertStopped(t)
+}
+
+func TestIterate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		seed     int
+		fn       func(int) int
+		takeN    uint
+		expected []int
+	}{
+		{"take_zero", 1, func(n int) int { return n * 2 }, 0, []int{}},
+		{"seed_only", 1, func(n int) int { return n * 2 }, 1, []int{1}},
+		{"powers_of_two", 1, func(n int) int { return n * 2 }, 5, []int{1, 2, 4, 8, 16}},
+		{"increment", 10, func(n int) int { return n + 1 }, 3, []int{10, 11, 12}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := slices.Collect(seq.Take(seq.Iterate(tc.seed, tc.fn), tc.takeN))
+
+			if !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
 func TestMap(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -450,6 +653,65 @@ func TestMap2(t *testing.T) {
 	}
 }
 
+func TestRange(t *testing.T) {
+	testCases := []struct {
+		name     string
+		start    int
+		end      int
+		step     int
+		expected []int
+	}{
+		{"ascending", 0, 5, 1, []int{0, 1, 2, 3, 4}},
+		{"ascending_with_step", 0, 10, 3, []int{0, 3, 6, 9}},
+		{"descending", 5, 0, -2, []int{5, 3, 1}},
+		{"empty_range", 3, 3, 1, []int{}},
+		{"start_past_end", 5, 0, 1, []int{}},
+		{"start_past_end_descending", 0, 5, -1, []int{}},
+		{"zero_step", 0, 5, 0, []int{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := slices.Collect(seq.Range(tc.start, tc.end, tc.step))
+
+			if !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRange_Float(t *testing.T) {
+	actual := slices.Collect(seq.Range(0.0, 1.0, 0.1))
+	expected := []float64{0, 0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9}
+
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, actual)
+	}
+
+	for i := range expected {
+		if math.Abs(actual[i]-expected[i]) > 1e-9 {
+			t.Errorf("expected %v, got %v", expected, actual)
+		}
+	}
+}
+
+func TestRange_Overflow(t *testing.T) {
+	actual := slices.Collect(seq.Range[uint8](250, 255, 3))
+	expected := []uint8{250, 253}
+
+	if !slices.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+
+	actual2 := slices.Collect(seq.Range[int8](-120, -128, -5))
+	expected2 := []int8{-120, -125}
+
+	if !slices.Equal(actual2, expected2) {
+		t.Errorf("expected %v, got %v", expected2, actual2)
+	}
+}
+
 func TestRepeat(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -495,6 +757,77 @@ func TestRepeat(t *testing.T) {
 	}
 }
 
+func TestRepeat2(t *testing.T) {
+	var actual []string
+
+	for k, v := range seq.Take2(seq.Repeat2("key", 42), 3) {
+		actual = append(actual, fmt.Sprintf("%s=%d", k, v))
+	}
+
+	expected := []string{"key=42", "key=42", "key=42"}
+
+	if !slices.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+func TestRepeatN(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    int
+		n        uint
+		expected []int
+	}{
+		{"repeat_zero_times", 10, 0, []int{}},
+		{"repeat_once", 7, 1, []int{7}},
+		{"repeat_multiple_times", 42, 3, []int{42, 42, 42}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := slices.Collect(seq.RepeatN(tc.value, tc.n))
+
+			if !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRoundRobin(t *testing.T) {
+	testCases := []struct {
+		name     string
+		seqs     []iter.Seq[int]
+		expected []int
+	}{
+		{"no_sequences", []iter.Seq[int]{}, []int{}},
+		{"single_sequence", []iter.Seq[int]{slices.Values([]int{1, 2, 3})}, []int{1, 2, 3}},
+		{"equal_length", []iter.Seq[int]{slices.Values([]int{1, 3}), slices.Values([]int{2, 4})}, []int{1, 2, 3, 4}},
+		{"different_lengths", []iter.Seq[int]{slices.Values([]int{1, 4}), slices.Values([]int{2}), slices.Values([]int{3, 5, 6})}, []int{1, 2, 3, 4, 5, 6}},
+		{"empty_sequence_mixed_with_non_empty", []iter.Seq[int]{slices.Values([]int{}), slices.Values([]int{1, 2})}, []int{1, 2}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := slices.Collect(seq.RoundRobin(tc.seqs...))
+
+			if !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRoundRobin_Cleanup(t *testing.T) {
+	tracker := &stopTracker{}
+
+	for range seq.RoundRobin(tracker.track(slices.Values([]int{1})), tracker.track(seq.Repeat(2))) {
+		break
+	}
+
+	tracker.assertStopped(t)
+}
+
 func TestSkip(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -1136,6 +1469,84 @@ func TestTakeWhile2(t *testing.T) {
 	}
 }
 
+func TestTap(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    []int
+		takeN    uint
+		expected []int
+	}{
+		{"empty_sequence", []int{}, 10, []int{}},
+		{"all_values", []int{1, 2, 3}, 10, []int{1, 2, 3}},
+		{"stop_early", []int{1, 2, 3}, 2, []int{1, 2}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			observed := []int{}
+
+			actual := slices.Collect(seq.Take(seq.Tap(slices.Values(tc.input), func(v int) {
+				observed = append(observed, v)
+			}), tc.takeN))
+
+			if !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+
+			if !slices.Equal(observed, tc.expected) {
+				t.Errorf("expected %v to be observed, got %v", tc.expected, observed)
+			}
+		})
+	}
+}
+
+func TestTap2(t *testing.T) {
+	input := map[string]int{"a": 1, "b": 2, "c": 3}
+	observed := map[string]int{}
+
+	actual := maps.Collect(seq.Tap2(maps.All(input), func(k string, v int) {
+		observed[k] = v
+	}))
+
+	if !maps.Equal(actual, input) {
+		t.Errorf("expected %v, got %v", input, actual)
+	}
+
+	if !maps.Equal(observed, input) {
+		t.Errorf("expected %v to be observed, got %v", input, observed)
+	}
+}
+
+func TestUnfold(t *testing.T) {
+	fibonacci := func(s [2]int) (int, [2]int, bool) {
+		return s[0], [2]int{s[1], s[0] + s[1]}, s[0] < 20
+	}
+
+	countdown := func(n int) (int, int, bool) {
+		return n, n - 1, n > 0
+	}
+
+	testCases := []struct {
+		name     string
+		seq      iter.Seq[int]
+		expected []int
+	}{
+		{"fibonacci", seq.Unfold([2]int{0, 1}, fibonacci), []int{0, 1, 1, 2, 3, 5, 8, 13}},
+		{"countdown", seq.Unfold(3, countdown), []int{3, 2, 1}},
+		{"immediate_termination", seq.Unfold(0, countdown), []int{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := slices.Collect(tc.seq)
+
+			if !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
 func TestUniq(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -1203,3 +1614,119 @@ func TestUniq2(t *testing.T) {
 		})
 	}
 }
+
+func TestWeightedRoundRobin(t *testing.T) {
+	testCases := []struct {
+		name     string
+		seqs     []seq.WeightedSeq[int]
+		expected []int
+	}{
+		{"no_sequences", []seq.WeightedSeq[int]{}, []int{}},
+		{
+			"weighted",
+			[]seq.WeightedSeq[int]{
+				{Seq: slices.Values([]int{1, 2, 3, 5, 6, 7}), Weight: 3},
+				{Seq: slices.Values([]int{4, 8}), Weight: 1},
+			},
+			[]int{1, 2, 3, 4, 5, 6, 7, 8},
+		},
+		{
+			"exhausted_mid_round",
+			[]seq.WeightedSeq[int]{
+				{Seq: slices.Values([]int{1, 2}), Weight: 3},
+				{Seq: slices.Values([]int{3, 4, 5}), Weight: 1},
+			},
+			[]int{1, 2, 3, 4, 5},
+		},
+		{
+			"zero_weight",
+			[]seq.WeightedSeq[int]{
+				{Seq: slices.Values([]int{1, 2}), Weight: 1},
+				{Seq: slices.Values([]int{3, 4}), Weight: 0},
+			},
+			[]int{1, 2},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := slices.Collect(seq.WeightedRoundRobin(tc.seqs...))
+
+			if !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestWeightedRoundRobin_Cleanup(t *testing.T) {
+	tracker := &stopTracker{}
+
+	weighted := seq.WeightedRoundRobin(
+		seq.WeightedSeq[int]{Seq: tracker.track(seq.Repeat(1)), Weight: 3},
+		seq.WeightedSeq[int]{Seq: tracker.track(seq.Repeat(2)), Weight: 1},
+	)
+
+	for range seq.Take(weighted, 5) {
+	}
+
+	tracker.assertStopped(t)
+}
+
+func TestStopsEarly(t *testing.T) {
+	values := slices.Values([]int{1, 2, 3, 4, 5, 6})
+	isEven := func(n int) bool { return n%2 == 0 }
+
+	testCases := []struct {
+		name string
+		seq  iter.Seq[int]
+	}{
+		{"Chain", seq.Chain(values, values)},
+		{"Filter", seq.Filter(values, isEven)},
+		{"FlatMap", seq.FlatMap(values, func(n int) iter.Seq[int] { return seq.RepeatN(n, 2) })},
+		{"Interleave", seq.Interleave(values, seq.Take(values, 2))},
+		{"Map", seq.Map(values, func(n int) int { return n * 2 })},
+		{"Range", seq.Range(0, 10, 3)},
+		{"RepeatN", seq.RepeatN(1, 3)},
+		{"RoundRobin", seq.RoundRobin(values, seq.Take(values, 2))},
+		{"Skip", seq.Skip(values, 2)},
+		{"SkipWhile", seq.SkipWhile(values, func(n int) bool { return n < 3 })},
+		{"Take", seq.Take(values, 4)},
+		{"TakeWhile", seq.TakeWhile(values, func(n int) bool { return n < 5 })},
+		{"Uniq", seq.Uniq(seq.Chain(values, values))},
+		{"WeightedRoundRobin", seq.WeightedRoundRobin(seq.WeightedSeq[int]{Seq: values, Weight: 2}, seq.WeightedSeq[int]{Seq: values, Weight: 1})},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			seqtest.AssertStopsEarly(t, tc.seq)
+			seqtest.AssertReiterable(t, tc.seq)
+		})
+	}
+}
+
+// stopTracker records whether tracked iterators that were started are also stopped (i.e. their iteration function returns).
+type stopTracker struct {
+	running int
+}
+
+func (s *stopTracker) track(seq iter.Seq[int]) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		s.running++
+		defer func() { s.running-- }()
+
+		for v := range seq {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func (s *stopTracker) assertStopped(t *testing.T) {
+	t.Helper()
+
+	if s.running != 0 {
+		t.Errorf("expected all iterators to be stopped, %d still running", s.running)
+	}
+}
@@ -0,0 +1,297 @@
+package seqtest
+
+import (
+	"fmt"
+	"iter"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/sagikazarmark/seq"
+)
+
+// Sample is an input of a [Law].
+type Sample struct {
+	// Values are the values yielded by the sequence under test.
+	Values []int
+
+	// N is an arbitrary number (e.g. the number of values to take or the position to stop the iteration at).
+	// It may exceed the number of values.
+	N uint
+
+	source func(values []int) iter.Seq[int]
+}
+
+// Seq returns a new sequence under test yielding the values of the sample.
+func (s Sample) Seq() iter.Seq[int] {
+	return s.source(s.Values)
+}
+
+// SeqOf returns a new sequence under test yielding values.
+func (s Sample) SeqOf(values []int) iter.Seq[int] {
+	return s.source(values)
+}
+
+func (s Sample) String() string {
+	return fmt.Sprintf("values=%v n=%d", s.Values, s.N)
+}
+
+// Law is an algebraic property that must hold for every [Sample].
+type Law struct {
+	// Name identifies the law in failure reports.
+	Name string
+
+	// Check returns an error if the law does not hold for the sample.
+	Check func(s Sample) error
+}
+
+// Laws checks algebraic laws of sequences and their combinators against generated samples.
+//
+// By default, the laws are checked against sequences created by [slices.Values],
+// verifying the combinators of the [seq] package.
+// Set Source to verify custom sequences (e.g. the iterator of a custom container)
+// and Extra to verify the laws of custom combinators.
+//
+// The built-in laws are:
+//
+//   - identity: the sequence yields the values of the sample
+//   - stops_early: the sequence honors yield returning false at position N
+//   - reiterable: the sequence yields the same values when iterated over again
+//   - take_skip: chaining Take(N) and Skip(N) reconstructs the sequence
+//   - take_while_skip_while: chaining TakeWhile and SkipWhile (with the same predicate) reconstructs the sequence
+//   - filter_composition: filtering twice equals filtering with the conjunction of the predicates
+//   - map_composition: mapping twice equals mapping with the composition of the functions
+//   - chain_associativity: Chain(Chain(a, b), c) equals Chain(a, Chain(b, c))
+//
+// Use [Laws.Fuzz] to check the laws with native fuzzing:
+//
+//	func FuzzList(f *testing.F) {
+//		laws := seqtest.Laws{
+//			Source: func(values []int) iter.Seq[int] { return NewList(values...).All() },
+//		}
+//
+//		laws.Fuzz(f)
+//	}
+//
+// Then run go test -fuzz FuzzList.
+type Laws struct {
+	// Source creates the sequence under test yielding values.
+	//
+	// The returned sequence is iterated over multiple times.
+	// If Source is nil, [slices.Values] is used.
+	Source func(values []int) iter.Seq[int]
+
+	// Extra laws to check in addition to the built-in ones.
+	Extra []Law
+}
+
+// Check checks the laws against a single sample.
+//
+// It reports whether all laws hold.
+func (l Laws) Check(t TB, values []int, n uint) bool {
+	t.Helper()
+
+	source := l.Source
+	if source == nil {
+		source = slices.Values[[]int]
+	}
+
+	sample := Sample{Values: values, N: n, source: source}
+
+	ok := true
+
+	for _, law := range slices.Concat(builtinLaws, l.Extra) {
+		var err error
+
+		if r := catchPanic(func() { err = law.Check(sample) }); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+
+		if err != nil {
+			t.Errorf("law %q does not hold for %v: %v", law.Name, sample, err)
+
+			ok = false
+		}
+	}
+
+	return ok
+}
+
+// Run checks the laws against randomly generated samples.
+//
+// Besides the random samples, edge cases (e.g. empty and single-element sequences) are always checked.
+//
+// It reports whether all laws hold.
+func (l Laws) Run(t TB, samples int) bool {
+	t.Helper()
+
+	for _, s := range seedSamples {
+		if !l.Check(t, s.values, s.n) {
+			return false
+		}
+	}
+
+	for range samples {
+		values := make([]int, rand.IntN(32))
+
+		// Use a small range of values to make duplicates likely
+		for i := range values {
+			values[i] = rand.IntN(16)
+		}
+
+		if !l.Check(t, values, uint(rand.IntN(len(values)+3))) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Fuzz checks the laws using native fuzzing (see [testing.F.Fuzz]).
+//
+// The seed corpus contains edge cases (e.g. empty and single-element sequences).
+// The fuzzer generates values from bytes (each byte becomes a value).
+func (l Laws) Fuzz(f *testing.F) {
+	for _, s := range seedSamples {
+		data := make([]byte, len(s.values))
+
+		for i, v := range s.values {
+			data[i] = byte(v)
+		}
+
+		f.Add(data, s.n)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte, n uint) {
+		values := make([]int, len(data))
+
+		for i, b := range data {
+			values[i] = int(b)
+		}
+
+		l.Check(t, values, n)
+	})
+}
+
+var seedSamples = []struct {
+	values []int
+	n      uint
+}{
+	{nil, 0},
+	{nil, 1},
+	{[]int{1}, 0},
+	{[]int{1}, 1},
+	{[]int{1}, 2},
+	{[]int{1, 2, 3}, 0},
+	{[]int{1, 2, 3}, 2},
+	{[]int{1, 2, 3}, 3},
+	{[]int{1, 2, 3}, 10},
+	{[]int{3, 3, 1, 2, 2, 4, 5, 6}, 4},
+}
+
+var builtinLaws = []Law{
+	{"identity", lawIdentity},
+	{"stops_early", lawStopsEarly},
+	{"reiterable", lawReiterable},
+	{"take_skip", lawTakeSkip},
+	{"take_while_skip_while", lawTakeWhileSkipWhile},
+	{"filter_composition", lawFilterComposition},
+	{"map_composition", lawMapComposition},
+	{"chain_associativity", lawChainAssociativity},
+}
+
+func lawIdentity(s Sample) error {
+	return compare(s.Values, slices.Collect(s.Seq()))
+}
+
+func lawStopsEarly(s Sample) error {
+	var got []int
+
+	seq.Checked(s.Seq())(func(v int) bool {
+		got = append(got, v)
+
+		return uint(len(got)) <= s.N
+	})
+
+	return compare(s.Values[:min(s.N+1, uint(len(s.Values)))], got)
+}
+
+func lawReiterable(s Sample) error {
+	it := s.Seq()
+
+	if err := compare(s.Values, slices.Collect(it)); err != nil {
+		return fmt.Errorf("first iteration: %w", err)
+	}
+
+	if err := compare(s.Values, slices.Collect(it)); err != nil {
+		return fmt.Errorf("second iteration: %w", err)
+	}
+
+	return nil
+}
+
+func lawTakeSkip(s Sample) error {
+	return compare(s.Values, slices.Collect(seq.Chain(seq.Take(s.Seq(), s.N), seq.Skip(s.Seq(), s.N))))
+}
+
+func lawTakeWhileSkipWhile(s Sample) error {
+	predicate := sampleModulo(s)
+
+	return compare(s.Values, slices.Collect(seq.Chain(seq.TakeWhile(s.Seq(), predicate), seq.SkipWhile(s.Seq(), predicate))))
+}
+
+func lawFilterComposition(s Sample) error {
+	p := func(v int) bool { return v%2 == 0 }
+	q := sampleModulo(s)
+
+	return compare(
+		slices.Collect(seq.Filter(s.Seq(), func(v int) bool { return p(v) && q(v) })),
+		slices.Collect(seq.Filter(seq.Filter(s.Seq(), p), q)),
+	)
+}
+
+func lawMapComposition(s Sample) error {
+	f := func(v int) int { return v*3 + 1 }
+	g := func(v int) int { return v % (int(s.N%7) + 1) }
+
+	return compare(
+		slices.Collect(seq.Map(s.Seq(), func(v int) int { return g(f(v)) })),
+		slices.Collect(seq.Map(seq.Map(s.Seq(), f), g)),
+	)
+}
+
+func lawChainAssociativity(s Sample) error {
+	// Split the values into three (possibly empty) parts
+	i := int(s.N % uint(len(s.Values)+1))
+	j := i + (len(s.Values)-i)/2
+
+	a, b, c := s.Values[:i], s.Values[i:j], s.Values[j:]
+
+	left := slices.Collect(seq.Chain(seq.Chain(s.SeqOf(a), s.SeqOf(b)), s.SeqOf(c)))
+	right := slices.Collect(seq.Chain(s.SeqOf(a), seq.Chain(s.SeqOf(b), s.SeqOf(c))))
+
+	if err := compare(s.Values, left); err != nil {
+		return fmt.Errorf("left-nested chain: %w", err)
+	}
+
+	if err := compare(s.Values, right); err != nil {
+		return fmt.Errorf("right-nested chain: %w", err)
+	}
+
+	return nil
+}
+
+// sampleModulo returns a predicate derived from the sample.
+func sampleModulo(s Sample) func(int) bool {
+	m := int(s.N%5) + 2
+
+	return func(v int) bool { return v%m != 0 }
+}
+
+func compare(want []int, got []int) error {
+	if !slices.Equal(want, got) {
+		return fmt.Errorf("want %v, got %v", want, got)
+	}
+
+	return nil
+}
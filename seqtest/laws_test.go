@@ -0,0 +1,114 @@
+package seqtest_test
+
+import (
+	"errors"
+	"iter"
+	"slices"
+	"testing"
+
+	"github.com/sagikazarmark/seq"
+	"github.com/sagikazarmark/seq/seqtest"
+)
+
+func FuzzLaws(f *testing.F) {
+	seqtest.Laws{}.Fuzz(f)
+}
+
+func TestLaws_Run(t *testing.T) {
+	laws := seqtest.Laws{
+		Extra: []seqtest.Law{
+			{
+				Name: "uniq_idempotent",
+				Check: func(s seqtest.Sample) error {
+					once := slices.Collect(seq.Uniq(s.Seq()))
+					twice := slices.Collect(seq.Uniq(seq.Uniq(s.Seq())))
+
+					if !slices.Equal(once, twice) {
+						return errors.New("uniq is not idempotent")
+					}
+
+					return nil
+				},
+			},
+		},
+	}
+
+	laws.Run(t, 100)
+}
+
+func TestLaws_Check(t *testing.T) {
+	testCases := []struct {
+		name        string
+		laws        seqtest.Laws
+		expectedErr string
+	}{
+		{
+			"valid_source",
+			seqtest.Laws{
+				Source: func(values []int) iter.Seq[int] { return seq.Checked(slices.Values(values)) },
+			},
+			"",
+		},
+		{
+			"ignores_false",
+			seqtest.Laws{
+				Source: func(values []int) iter.Seq[int] {
+					return func(yield func(int) bool) {
+						for _, v := range values {
+							yield(v)
+						}
+					}
+				},
+			},
+			`law "stops_early" does not hold for values=[1 2 3] n=1: panic: iterator contract violation: yield called again after it returned false`,
+		},
+		{
+			"single_use",
+			seqtest.Laws{
+				Source: func(values []int) iter.Seq[int] { return seq.Once(slices.Values(values)) },
+			},
+			`law "reiterable" does not hold for values=[1 2 3] n=1: panic: sequence can only be iterated once`,
+		},
+		{
+			"extra_law",
+			seqtest.Laws{
+				Extra: []seqtest.Law{
+					{
+						Name: "always_empty",
+						Check: func(s seqtest.Sample) error {
+							if len(s.Values) > 0 {
+								return errors.New("not empty")
+							}
+
+							return nil
+						},
+					},
+				},
+			},
+			`law "always_empty" does not hold for values=[1 2 3] n=1: not empty`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tb := &fakeTB{}
+
+			ok := tc.laws.Check(tb, []int{1, 2, 3}, 1)
+
+			if tc.expectedErr == "" {
+				tb.assert(t, ok, "")
+
+				return
+			}
+
+			// A broken sequence may violate multiple laws
+			if ok {
+				t.Error("expected laws not to hold")
+			}
+
+			if !slices.Contains(tb.errors, tc.expectedErr) {
+				t.Errorf("expected error %q, got %q", tc.expectedErr, tb.errors)
+			}
+		})
+	}
+}
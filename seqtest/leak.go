@@ -0,0 +1,91 @@
+package seqtest
+
+import (
+	"bytes"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
+)
+
+// LeakTimeout is the maximum amount of time [AssertNoLeak] waits for goroutines to exit.
+var LeakTimeout = 2 * time.Second
+
+// AssertNoLeak asserts that fn does not leave goroutines running behind.
+//
+// It is useful for testing iterators consuming sequences in separate goroutines:
+// these goroutines should exit when the iteration ends (even if it is stopped early).
+//
+// Goroutines may exit asynchronously, so AssertNoLeak waits up to [LeakTimeout] for them to exit.
+//
+// Goroutines started concurrently with fn (e.g. by parallel tests) are also considered leaked,
+// so AssertNoLeak should not be used in parallel tests.
+//
+// It reports whether the assertion succeeded.
+func AssertNoLeak(t TB, fn func()) bool {
+	t.Helper()
+
+	before := goroutines()
+
+	fn()
+
+	deadline := time.Now().Add(LeakTimeout)
+	backoff := time.Millisecond
+
+	for {
+		var leaked []string
+
+		for id, stack := range goroutines() {
+			if _, ok := before[id]; !ok {
+				leaked = append(leaked, stack)
+			}
+		}
+
+		if len(leaked) == 0 {
+			return true
+		}
+
+		if time.Now().After(deadline) {
+			slices.Sort(leaked)
+
+			t.Errorf("%d goroutine(s) leaked:\n\n%s", len(leaked), strings.Join(leaked, "\n\n"))
+
+			return false
+		}
+
+		time.Sleep(backoff)
+
+		backoff = min(2*backoff, 100*time.Millisecond)
+	}
+}
+
+// goroutines returns the stack traces of all running goroutines keyed by their header (e.g. "goroutine 1").
+func goroutines() map[string]string {
+	buf := make([]byte, 64<<10)
+
+	for {
+		n := runtime.Stack(buf, true)
+
+		if n < len(buf) {
+			buf = buf[:n]
+
+			break
+		}
+
+		buf = make([]byte, 2*len(buf))
+	}
+
+	stacks := make(map[string]string)
+
+	for _, stack := range bytes.Split(buf, []byte("\n\n")) {
+		// Stack traces start with a header like "goroutine 1 [running]:"
+		id, _, ok := bytes.Cut(stack, []byte(" ["))
+		if !ok {
+			continue
+		}
+
+		stacks[string(id)] = string(stack)
+	}
+
+	return stacks
+}
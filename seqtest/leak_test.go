@@ -0,0 +1,44 @@
+package seqtest_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sagikazarmark/seq/seqtest"
+)
+
+func TestAssertNoLeak(t *testing.T) {
+	t.Run("no_leak", func(t *testing.T) {
+		tb := &fakeTB{}
+
+		ok := seqtest.AssertNoLeak(tb, func() {
+			done := make(chan struct{})
+
+			go func() {
+				time.Sleep(10 * time.Millisecond)
+				close(done)
+			}()
+		})
+
+		tb.assert(t, ok, "")
+	})
+
+	t.Run("leak", func(t *testing.T) {
+		defer func(timeout time.Duration) { seqtest.LeakTimeout = timeout }(seqtest.LeakTimeout)
+
+		seqtest.LeakTimeout = 50 * time.Millisecond
+
+		release := make(chan struct{})
+		defer close(release)
+
+		tb := &fakeTB{}
+
+		ok := seqtest.AssertNoLeak(tb, func() {
+			go func() {
+				<-release
+			}()
+		})
+
+		tb.assert(t, ok, "1 goroutine(s) leaked")
+	})
+}
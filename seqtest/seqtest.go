@@ -0,0 +1,191 @@
+// Package seqtest provides utilities for testing iterator sequences.
+package seqtest
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/sagikazarmark/seq"
+)
+
+// TB is the subset of [testing.TB] used by the assertions in this package.
+type TB interface {
+	Helper()
+	Errorf(format string, args ...any)
+}
+
+// Pair is a key-value pair yielded by an [iter.Seq2].
+type Pair[K any, V any] struct {
+	Key   K
+	Value V
+}
+
+func (p Pair[K, V]) String() string {
+	return fmt.Sprintf("(%v, %v)", p.Key, p.Value)
+}
+
+// Pairs collects the key-value pairs yielded by seq into a slice.
+func Pairs[K any, V any](seq iter.Seq2[K, V]) []Pair[K, V] {
+	var pairs []Pair[K, V]
+
+	for k, v := range seq {
+		pairs = append(pairs, Pair[K, V]{k, v})
+	}
+
+	return pairs
+}
+
+// Equal asserts that seq yields the values in want (in the same order).
+//
+// It reports whether the assertion succeeded.
+func Equal[V comparable](t TB, seq iter.Seq[V], want []V) bool {
+	t.Helper()
+
+	got := slices.Collect(seq)
+
+	if !slices.Equal(got, want) {
+		t.Errorf("sequence mismatch:\nwant: %v\n got: %v", want, got)
+
+		return false
+	}
+
+	return true
+}
+
+// EqualPairs asserts that seq yields the key-value pairs in want (in the same order).
+//
+// It reports whether the assertion succeeded.
+func EqualPairs[K comparable, V comparable](t TB, seq iter.Seq2[K, V], want []Pair[K, V]) bool {
+	t.Helper()
+
+	got := Pairs(seq)
+
+	if !slices.Equal(got, want) {
+		t.Errorf("sequence mismatch:\nwant: %v\n got: %v", want, got)
+
+		return false
+	}
+
+	return true
+}
+
+// AssertStopsEarly asserts that seq honors yield returning false at every position.
+//
+// The sequence is iterated over repeatedly, stopping after the first, second, third, etc. value,
+// until an iteration ends before it could be stopped.
+// Each iteration is checked for violations of the iterator contract (see [seq.Checked]),
+// such as calling yield again after it returned false.
+//
+// The sequence must be finite and reiterable (see [AssertReiterable]).
+//
+// It reports whether the assertion succeeded.
+func AssertStopsEarly[V any](t TB, s iter.Seq[V]) bool {
+	t.Helper()
+
+	return assertStopsEarly(t, func(yield func() bool) {
+		seq.Checked(s)(func(V) bool { return yield() })
+	})
+}
+
+// AssertStopsEarly2 asserts that seq honors yield returning false at every position.
+//
+// See [AssertStopsEarly] for details.
+func AssertStopsEarly2[K any, V any](t TB, s iter.Seq2[K, V]) bool {
+	t.Helper()
+
+	return assertStopsEarly(t, func(yield func() bool) {
+		seq.Checked2(s)(func(K, V) bool { return yield() })
+	})
+}
+
+// assertStopsEarly calls iterate with a yield function stopping the iteration at increasing positions.
+func assertStopsEarly(t TB, iterate func(yield func() bool)) bool {
+	t.Helper()
+
+	for stopAt := 1; ; stopAt++ {
+		var count int
+
+		r := catchPanic(func() {
+			iterate(func() bool {
+				count++
+
+				return count < stopAt
+			})
+		})
+
+		if r != nil {
+			t.Errorf("iteration stopped after %d value(s) panicked: %v", stopAt, r)
+
+			return false
+		}
+
+		if count < stopAt {
+			return true
+		}
+	}
+}
+
+// AssertReiterable asserts that seq yields the same values when it is iterated over twice.
+//
+// Iterators wrapping resources that cannot be rewound (e.g. readers) usually fail this assertion.
+// A panic during the second iteration (e.g. raised by [seq.Once]) is reported as a failure.
+//
+// It reports whether the assertion succeeded.
+func AssertReiterable[V comparable](t TB, seq iter.Seq[V]) bool {
+	t.Helper()
+
+	first := slices.Collect(seq)
+
+	var second []V
+
+	if r := catchPanic(func() { second = slices.Collect(seq) }); r != nil {
+		t.Errorf("sequence panicked when iterated again: %v", r)
+
+		return false
+	}
+
+	if !slices.Equal(first, second) {
+		t.Errorf("sequence yielded different values when iterated again:\nfirst: %v\nsecond: %v", first, second)
+
+		return false
+	}
+
+	return true
+}
+
+// AssertReiterable2 asserts that seq yields the same key-value pairs when it is iterated over twice.
+//
+// See [AssertReiterable] for details.
+func AssertReiterable2[K comparable, V comparable](t TB, seq iter.Seq2[K, V]) bool {
+	t.Helper()
+
+	first := Pairs(seq)
+
+	var second []Pair[K, V]
+
+	if r := catchPanic(func() { second = Pairs(seq) }); r != nil {
+		t.Errorf("sequence panicked when iterated again: %v", r)
+
+		return false
+	}
+
+	if !slices.Equal(first, second) {
+		t.Errorf("sequence yielded different values when iterated again:\nfirst: %v\nsecond: %v", first, second)
+
+		return false
+	}
+
+	return true
+}
+
+// catchPanic calls fn and returns the recovered panic value (if any).
+func catchPanic(fn func()) (r any) {
+	defer func() {
+		r = recover()
+	}()
+
+	fn()
+
+	return nil
+}
@@ -0,0 +1,193 @@
+package seqtest_test
+
+import (
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/sagikazarmark/seq"
+	"github.com/sagikazarmark/seq/seqtest"
+)
+
+// fakeTB records reported errors instead of failing the test.
+type fakeTB struct {
+	errors []string
+}
+
+func (*fakeTB) Helper() {}
+
+func (t *fakeTB) Errorf(format string, args ...any) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func (t *fakeTB) assert(tb testing.TB, ok bool, expectedErr string) {
+	tb.Helper()
+
+	if expectedErr == "" {
+		if !ok || len(t.errors) > 0 {
+			tb.Errorf("expected assertion to succeed, got errors: %q", t.errors)
+		}
+
+		return
+	}
+
+	if ok {
+		tb.Error("expected assertion to fail")
+	}
+
+	if len(t.errors) != 1 || !strings.Contains(t.errors[0], expectedErr) {
+		tb.Errorf("expected a single error containing %q, got %q", expectedErr, t.errors)
+	}
+}
+
+// brokenSeq ignores yield returning false.
+func brokenSeq(yield func(int) bool) {
+	for i := range 3 {
+		yield(i)
+	}
+}
+
+// brokenSeq2 ignores yield returning false.
+func brokenSeq2(yield func(int, string) bool) {
+	for i := range 3 {
+		yield(i, "")
+	}
+}
+
+// countingSeq yields a different number of values every time it is iterated over.
+func countingSeq() iter.Seq[int] {
+	var n int
+
+	return func(yield func(int) bool) {
+		n++
+
+		for i := range n {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func TestEqual(t *testing.T) {
+	testCases := []struct {
+		name        string
+		seq         iter.Seq[int]
+		want        []int
+		expectedErr string
+	}{
+		{"equal", slices.Values([]int{1, 2, 3}), []int{1, 2, 3}, ""},
+		{"empty", slices.Values([]int{}), nil, ""},
+		{"different_values", slices.Values([]int{1, 2, 3}), []int{1, 2, 4}, "want: [1 2 4]\n got: [1 2 3]"},
+		{"different_length", slices.Values([]int{1, 2}), []int{1, 2, 3}, "want: [1 2 3]\n got: [1 2]"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tb := &fakeTB{}
+
+			tb.assert(t, seqtest.Equal(tb, tc.seq, tc.want), tc.expectedErr)
+		})
+	}
+}
+
+func TestEqualPairs(t *testing.T) {
+	testCases := []struct {
+		name        string
+		seq         iter.Seq2[int, string]
+		want        []seqtest.Pair[int, string]
+		expectedErr string
+	}{
+		{
+			"equal",
+			slices.All([]string{"a", "b"}),
+			[]seqtest.Pair[int, string]{{0, "a"}, {1, "b"}},
+			"",
+		},
+		{
+			"different_values",
+			slices.All([]string{"a", "b"}),
+			[]seqtest.Pair[int, string]{{0, "a"}, {1, "c"}},
+			"want: [(0, a) (1, c)]\n got: [(0, a) (1, b)]",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tb := &fakeTB{}
+
+			tb.assert(t, seqtest.EqualPairs(tb, tc.seq, tc.want), tc.expectedErr)
+		})
+	}
+}
+
+func TestAssertStopsEarly(t *testing.T) {
+	testCases := []struct {
+		name        string
+		seq         iter.Seq[int]
+		expectedErr string
+	}{
+		{"empty", slices.Values([]int{}), ""},
+		{"values", slices.Values([]int{1, 2, 3}), ""},
+		{"combinator", seq.Filter(slices.Values([]int{1, 2, 3, 4}), func(n int) bool { return n%2 == 0 }), ""},
+		{"ignores_false", brokenSeq, "iteration stopped after 1 value(s) panicked: iterator contract violation: yield called again after it returned false"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tb := &fakeTB{}
+
+			tb.assert(t, seqtest.AssertStopsEarly(tb, tc.seq), tc.expectedErr)
+		})
+	}
+}
+
+func TestAssertStopsEarly2(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		tb := &fakeTB{}
+
+		tb.assert(t, seqtest.AssertStopsEarly2(tb, maps.All(map[int]string{1: "a", 2: "b"})), "")
+	})
+
+	t.Run("ignores_false", func(t *testing.T) {
+		tb := &fakeTB{}
+
+		tb.assert(t, seqtest.AssertStopsEarly2(tb, brokenSeq2), "yield called again after it returned false")
+	})
+}
+
+func TestAssertReiterable(t *testing.T) {
+	testCases := []struct {
+		name        string
+		seq         iter.Seq[int]
+		expectedErr string
+	}{
+		{"reiterable", slices.Values([]int{1, 2, 3}), ""},
+		{"single_use", countingSeq(), "first: [0]\nsecond: [0 1]"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tb := &fakeTB{}
+
+			tb.assert(t, seqtest.AssertReiterable(tb, tc.seq), tc.expectedErr)
+		})
+	}
+}
+
+func TestAssertReiterable2(t *testing.T) {
+	t.Run("reiterable", func(t *testing.T) {
+		tb := &fakeTB{}
+
+		tb.assert(t, seqtest.AssertReiterable2(tb, slices.All([]string{"a", "b"})), "")
+	})
+
+	t.Run("single_use", func(t *testing.T) {
+		tb := &fakeTB{}
+
+		tb.assert(t, seqtest.AssertReiterable2(tb, seq.Once2(slices.All([]string{"a", "b"}))), "sequence panicked when iterated again: sequence can only be iterated once")
+	})
+}
@@ -0,0 +1,116 @@
+package seq
+
+import (
+	"database/sql"
+	"fmt"
+	"iter"
+	"reflect"
+	"strings"
+)
+
+// Rows creates an iterator over the rows of a database query result, using scan to convert each row to a value.
+//
+// Rows are always closed when the iteration ends, including when the consumer stops early.
+// [sql.Rows.Err] is checked after the last row.
+//
+// The iterator is single-use: rows cannot be iterated over again.
+//
+// If scan or the query fails, the iterator yields the error (along with the zero value of V) and stops.
+func Rows[V any](rows *sql.Rows, scan func(*sql.Rows) (V, error)) iter.Seq2[V, error] {
+	return func(yield func(V, error) bool) {
+		defer func() { _ = rows.Close() }()
+
+		var zero V
+
+		for rows.Next() {
+			v, err := scan(rows)
+			if err != nil {
+				yield(zero, err)
+
+				return
+			}
+
+			if !yield(v, nil) {
+				return
+			}
+		}
+
+		if err := rows.Err(); err != nil {
+			yield(zero, err)
+		}
+	}
+}
+
+// ScanStruct scans the current row into a struct of type V.
+//
+// Columns are mapped to struct fields by the name in their db tag (or the field name if there is no tag).
+// Names are matched case-insensitively.
+// Fields tagged with "-" and unexported fields are ignored, and so are columns without a matching field.
+//
+// Fields can be of any type supported by [sql.Rows.Scan] (including [sql.Scanner] implementations).
+//
+// ScanStruct can be used as the scan function of [Rows]:
+//
+//	users := seq.Rows(rows, seq.ScanStruct[User])
+func ScanStruct[V any](rows *sql.Rows) (V, error) {
+	var v V
+
+	rv := reflect.ValueOf(&v).Elem()
+	if rv.Kind() != reflect.Struct {
+		return v, fmt.Errorf("unsupported type %s: must be a struct", rv.Type())
+	}
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return v, err
+	}
+
+	fields := dbFields(rv.Type())
+	dest := make([]any, len(columns))
+
+	for i, column := range columns {
+		index, ok := fields[strings.ToLower(column)]
+		if !ok {
+			dest[i] = new(any)
+
+			continue
+		}
+
+		dest[i] = rv.Field(index).Addr().Interface()
+	}
+
+	if err := rows.Scan(dest...); err != nil {
+		return v, err
+	}
+
+	return v, nil
+}
+
+// dbFields maps the (lower case) column names to field indices of a struct type.
+func dbFields(typ reflect.Type) map[string]int {
+	fields := make(map[string]int, typ.NumField())
+
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+
+		if !field.IsExported() {
+			continue
+		}
+
+		name := field.Name
+
+		if tag, ok := field.Tag.Lookup("db"); ok {
+			if tag == "-" {
+				continue
+			}
+
+			if tag != "" {
+				name = tag
+			}
+		}
+
+		fields[strings.ToLower(name)] = i
+	}
+
+	return fields
+}
@@ -0,0 +1,309 @@
+package seq_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/sagikazarmark/seq"
+)
+
+// fakeDriver is a minimal database driver that returns predefined results for any query.
+// The data source name selects the result.
+type fakeDriver struct {
+	mu      sync.Mutex
+	results map[string]fakeResult
+	open    int
+}
+
+type fakeResult struct {
+	columns []string
+	rows    [][]driver.Value
+	err     error // returned after all rows are read
+}
+
+var testDriver = &fakeDriver{results: make(map[string]fakeResult)}
+
+func init() {
+	sql.Register("seqfake", testDriver)
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) {
+	return &fakeConn{driver: d, name: name}, nil
+}
+
+type fakeConn struct {
+	driver *fakeDriver
+	name   string
+}
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not implemented") }
+func (c *fakeConn) Close() error                        { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)           { return nil, errors.New("not implemented") }
+
+func (c *fakeConn) QueryContext(context.Context, string, []driver.NamedValue) (driver.Rows, error) {
+	c.driver.mu.Lock()
+	defer c.driver.mu.Unlock()
+
+	c.driver.open++
+
+	return &fakeRows{driver: c.driver, result: c.driver.results[c.name]}, nil
+}
+
+type fakeRows struct {
+	driver *fakeDriver
+	result fakeResult
+	pos    int
+}
+
+func (r *fakeRows) Columns() []string { return r.result.columns }
+
+func (r *fakeRows) Close() error {
+	r.driver.mu.Lock()
+	defer r.driver.mu.Unlock()
+
+	r.driver.open--
+
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.result.rows) {
+		if r.result.err != nil {
+			return r.result.err
+		}
+
+		return io.EOF
+	}
+
+	copy(dest, r.result.rows[r.pos])
+	r.pos++
+
+	return nil
+}
+
+func queryFake(t *testing.T, result fakeResult) *sql.Rows {
+	t.Helper()
+
+	testDriver.mu.Lock()
+	testDriver.results[t.Name()] = result
+	testDriver.mu.Unlock()
+
+	db, err := sql.Open("seqfake", t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { _ = db.Close() })
+
+	rows, err := db.Query("SELECT")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return rows
+}
+
+func assertRowsClosed(t *testing.T) {
+	t.Helper()
+
+	testDriver.mu.Lock()
+	defer testDriver.mu.Unlock()
+
+	if testDriver.open != 0 {
+		t.Errorf("expected rows to be closed, %d still open", testDriver.open)
+	}
+}
+
+var fakeUsers = fakeResult{
+	columns: []string{"id", "name", "email"},
+	rows: [][]driver.Value{
+		{int64(1), "alice", "alice@example.com"},
+		{int64(2), "bob", nil},
+		{int64(3), "charlie", "charlie@example.com"},
+	},
+}
+
+func scanName(rows *sql.Rows) (string, error) {
+	var (
+		id    int
+		name  string
+		email sql.NullString
+	)
+
+	err := rows.Scan(&id, &name, &email)
+
+	return name, err
+}
+
+func TestRows(t *testing.T) {
+	rows := queryFake(t, fakeUsers)
+
+	var actual []string
+
+	for name, err := range seq.Rows(rows, scanName) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		actual = append(actual, name)
+	}
+
+	if expected := []string{"alice", "bob", "charlie"}; !slices.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+
+	assertRowsClosed(t)
+}
+
+func TestRows_StopEarly(t *testing.T) {
+	rows := queryFake(t, fakeUsers)
+
+	for range seq.Rows(rows, scanName) {
+		break
+	}
+
+	assertRowsClosed(t)
+}
+
+func TestRows_Error(t *testing.T) {
+	queryErr := errors.New("connection lost")
+
+	testCases := []struct {
+		name     string
+		result   fakeResult
+		scan     func(*sql.Rows) (string, error)
+		expected []string
+		err      error
+	}{
+		{
+			"rows_error",
+			fakeResult{columns: fakeUsers.columns, rows: fakeUsers.rows[:1], err: queryErr},
+			scanName,
+			[]string{"alice"},
+			queryErr,
+		},
+		{
+			"scan_error",
+			fakeUsers,
+			func(*sql.Rows) (string, error) { return "", queryErr },
+			nil,
+			queryErr,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rows := queryFake(t, tc.result)
+
+			var (
+				actual []string
+				errs   []error
+			)
+
+			for name, err := range seq.Rows(rows, tc.scan) {
+				if err != nil {
+					errs = append(errs, err)
+
+					continue
+				}
+
+				actual = append(actual, name)
+			}
+
+			if !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+
+			if len(errs) != 1 || !errors.Is(errs[0], tc.err) {
+				t.Errorf("expected %v, got %v", tc.err, errs)
+			}
+
+			assertRowsClosed(t)
+		})
+	}
+}
+
+type dbUser struct {
+	ID       int64
+	Name     string         `db:"name"`
+	Email    sql.NullString `db:"email"`
+	Password string         `db:"-"`
+	internal string
+}
+
+func TestScanStruct(t *testing.T) {
+	rows := queryFake(t, fakeResult{
+		columns: []string{"ID", "name", "email", "password", "internal", "created_at"},
+		rows: [][]driver.Value{
+			{int64(1), "alice", "alice@example.com", "secret", "x", "2024-01-01"},
+			{int64(2), "bob", nil, "secret", "x", "2024-01-01"},
+		},
+	})
+
+	var actual []dbUser
+
+	for user, err := range seq.Rows(rows, seq.ScanStruct[dbUser]) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		actual = append(actual, user)
+	}
+
+	expected := []dbUser{
+		{ID: 1, Name: "alice", Email: sql.NullString{String: "alice@example.com", Valid: true}},
+		{ID: 2, Name: "bob"},
+	}
+
+	if !slices.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+func TestScanStruct_Error(t *testing.T) {
+	testCases := []struct {
+		name   string
+		result fakeResult
+		scan   func(*sql.Rows) error
+	}{
+		{
+			"incompatible_type",
+			fakeResult{columns: []string{"id"}, rows: [][]driver.Value{{"not a number"}}},
+			func(rows *sql.Rows) error {
+				_, err := seq.ScanStruct[dbUser](rows)
+
+				return err
+			},
+		},
+		{
+			"not_a_struct",
+			fakeResult{columns: []string{"id"}, rows: [][]driver.Value{{int64(1)}}},
+			func(rows *sql.Rows) error {
+				_, err := seq.ScanStruct[int](rows)
+
+				return err
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rows := queryFake(t, tc.result)
+			defer func() { _ = rows.Close() }()
+
+			if !rows.Next() {
+				t.Fatal("expected a row")
+			}
+
+			if err := tc.scan(rows); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
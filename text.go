@@ -0,0 +1,191 @@
+package seq
+
+import (
+	"iter"
+	"regexp"
+	"regexp/syntax"
+	"unicode"
+	"unicode/utf8"
+)
+
+// RegexpMatches creates an iterator over the successive non-overlapping matches of re in text.
+//
+// The iterator yields the byte offset of each match in text along with the matched text.
+// Matches are found lazily, in the same order as [regexp.Regexp.FindAllString] would return them.
+//
+// Patterns containing assertions looking behind the current position (^, \A, \b or \B) are matched eagerly:
+// all matches are found (and allocated) when the iteration starts.
+func RegexpMatches(re *regexp.Regexp, text string) iter.Seq2[int, string] {
+	matches := regexpMatchIndices(re, text)
+
+	return func(yield func(int, string) bool) {
+		for loc := range matches {
+			if !yield(loc[0], text[loc[0]:loc[1]]) {
+				return
+			}
+		}
+	}
+}
+
+// RegexpSubmatches creates an iterator over the successive non-overlapping matches of re in text
+// including the matches of its subexpressions.
+//
+// The iterator yields the byte offset of each match in text along with the match and its submatches
+// (see [regexp.Regexp.FindStringSubmatch]).
+// Matches are found lazily, in the same order as [regexp.Regexp.FindAllStringSubmatch] would return them.
+//
+// Patterns containing assertions looking behind the current position (^, \A, \b or \B) are matched eagerly:
+// all matches are found (and allocated) when the iteration starts.
+func RegexpSubmatches(re *regexp.Regexp, text string) iter.Seq2[int, []string] {
+	matches := regexpMatchIndices(re, text)
+
+	return func(yield func(int, []string) bool) {
+		for loc := range matches {
+			match := make([]string, len(loc)/2)
+
+			for i := range match {
+				if loc[2*i] >= 0 {
+					match[i] = text[loc[2*i]:loc[2*i+1]]
+				}
+			}
+
+			if !yield(loc[0], match) {
+				return
+			}
+		}
+	}
+}
+
+// regexpMatchIndices yields the submatch indices (relative to text) of successive non-overlapping matches.
+//
+// It follows the same rules as the FindAll methods of [regexp.Regexp] regarding empty matches.
+func regexpMatchIndices(re *regexp.Regexp, text string) iter.Seq[[]int] {
+	// Matching against the remainder of the text changes the meaning of assertions looking behind the current position.
+	eager := regexpLooksBehind(re)
+
+	return func(yield func([]int) bool) {
+		if eager {
+			for _, loc := range re.FindAllStringSubmatchIndex(text, -1) {
+				if !yield(loc) {
+					return
+				}
+			}
+
+			return
+		}
+
+		prevMatchEnd := -1
+
+		for pos := 0; pos <= len(text); {
+			loc := re.FindStringSubmatchIndex(text[pos:])
+			if loc == nil {
+				return
+			}
+
+			for i := range loc {
+				if loc[i] >= 0 {
+					loc[i] += pos
+				}
+			}
+
+			accept := true
+
+			if loc[1] == loc[0] {
+				// Do not allow an empty match right after a previous match
+				if loc[0] == prevMatchEnd {
+					accept = false
+				}
+
+				if loc[1] < len(text) {
+					_, width := utf8.DecodeRuneInString(text[loc[1]:])
+					pos = loc[1] + width
+				} else {
+					pos = len(text) + 1
+				}
+			} else {
+				pos = loc[1]
+			}
+
+			prevMatchEnd = loc[1]
+
+			if accept && !yield(loc) {
+				return
+			}
+		}
+	}
+}
+
+// regexpLooksBehind reports whether re contains empty-width assertions that depend on the text before the current position.
+func regexpLooksBehind(re *regexp.Regexp) bool {
+	parsed, err := syntax.Parse(re.String(), syntax.Perl)
+	if err != nil {
+		return true
+	}
+
+	var check func(*syntax.Regexp) bool
+
+	check = func(r *syntax.Regexp) bool {
+		switch r.Op {
+		case syntax.OpBeginLine, syntax.OpBeginText, syntax.OpWordBoundary, syntax.OpNoWordBoundary:
+			return true
+		}
+
+		for _, sub := range r.Sub {
+			if check(sub) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return check(parsed)
+}
+
+// Tokenizer splits text into tokens separated by delimiter runes.
+type Tokenizer struct {
+	// IsDelimiter reports whether a rune is a delimiter.
+	//
+	// Defaults to [unicode.IsSpace].
+	IsDelimiter func(rune) bool
+
+	// KeepDelimiters yields every delimiter rune as a separate token.
+	KeepDelimiters bool
+}
+
+// Tokens creates an iterator over the tokens of text.
+//
+// Empty tokens (between consecutive delimiters) are never yielded.
+func (t Tokenizer) Tokens(text string) iter.Seq[string] {
+	isDelimiter := t.IsDelimiter
+	if isDelimiter == nil {
+		isDelimiter = unicode.IsSpace
+	}
+
+	return func(yield func(string) bool) {
+		start := 0
+
+		for i, r := range text {
+			if !isDelimiter(r) {
+				continue
+			}
+
+			if i > start && !yield(text[start:i]) {
+				return
+			}
+
+			_, width := utf8.DecodeRuneInString(text[i:])
+			end := i + width
+
+			if t.KeepDelimiters && !yield(text[i:end]) {
+				return
+			}
+
+			start = end
+		}
+
+		if start < len(text) {
+			yield(text[start:])
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package seq_test
+
+import (
+	"fmt"
+	"regexp"
+	"unicode"
+
+	"github.com/sagikazarmark/seq"
+)
+
+func ExampleRegexpMatches() {
+	re := regexp.MustCompile(`\d+`)
+
+	for i, match := range seq.RegexpMatches(re, "order 66 costs 42 credits") {
+		fmt.Println(i, match)
+	}
+
+	// Output:
+	// 6 66
+	// 15 42
+}
+
+func ExampleRegexpSubmatches() {
+	re := regexp.MustCompile(`(\w+)=(\w+)`)
+
+	for _, match := range seq.RegexpSubmatches(re, "name=alice role=admin") {
+		fmt.Println(match[1], match[2])
+	}
+
+	// Output:
+	// name alice
+	// role admin
+}
+
+func ExampleTokenizer() {
+	tokenizer := seq.Tokenizer{
+		IsDelimiter: func(r rune) bool {
+			return unicode.IsSpace(r) || r == '+' || r == '*'
+		},
+		KeepDelimiters: true,
+	}
+
+	notSpace := func(s string) bool { return s != " " }
+
+	for token := range seq.Filter(tokenizer.Tokens("1 + 2*3"), notSpace) {
+		fmt.Println(token)
+	}
+
+	// Output:
+	// 1
+	// +
+	// 2
+	// *
+	// 3
+}
@@ -0,0 +1,116 @@
+package seq_test
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+	"unicode"
+
+	"github.com/sagikazarmark/seq"
+)
+
+func TestRegexpMatches(t *testing.T) {
+	testCases := []struct {
+		name    string
+		pattern string
+		text    string
+	}{
+		{"no_matches", `\d+`, "abc"},
+		{"simple", `\d+`, "a1 b22 c333"},
+		{"empty_text", `a*`, ""},
+		{"empty_matches", `a*`, "baaac"},
+		{"empty_matches_unicode", `x*`, "héllo"},
+		{"line_anchors", `(?m)^\w+`, "foo bar\nbaz qux"},
+		{"text_anchor", `^a`, "aaa"},
+		{"word_boundary", `\bb\w*`, "abc bcd"},
+		{"end_anchor", `\w+$`, "foo bar"},
+		{"alternation", `foo|bar`, "foobarbaz"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			re := regexp.MustCompile(tc.pattern)
+
+			expected := []string{}
+			for _, loc := range re.FindAllStringIndex(tc.text, -1) {
+				expected = append(expected, fmt.Sprintf("%d:%s", loc[0], tc.text[loc[0]:loc[1]]))
+			}
+
+			actual := []string{}
+			for i, match := range seq.RegexpMatches(re, tc.text) {
+				actual = append(actual, fmt.Sprintf("%d:%s", i, match))
+			}
+
+			if !slices.Equal(actual, expected) {
+				t.Errorf("expected %q, got %q", expected, actual)
+			}
+		})
+	}
+}
+
+func TestRegexpSubmatches(t *testing.T) {
+	re := regexp.MustCompile(`(\w+)=(\d+)?`)
+	text := "a=1, b=, c=33"
+
+	var actual [][]string
+
+	for _, match := range seq.RegexpSubmatches(re, text) {
+		actual = append(actual, match)
+	}
+
+	expected := re.FindAllStringSubmatch(text, -1)
+
+	if !equalSlices(actual, expected) {
+		t.Errorf("expected %q, got %q", expected, actual)
+	}
+}
+
+func TestRegexpMatches_StopEarly(t *testing.T) {
+	re := regexp.MustCompile(`\d`)
+
+	var actual []string
+
+	for _, match := range seq.RegexpMatches(re, "1 2 3 4") {
+		actual = append(actual, match)
+
+		if len(actual) == 2 {
+			break
+		}
+	}
+
+	if expected := []string{"1", "2"}; !slices.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+func TestTokenizer(t *testing.T) {
+	punctuation := func(r rune) bool { return unicode.IsSpace(r) || unicode.IsPunct(r) }
+
+	testCases := []struct {
+		name      string
+		tokenizer seq.Tokenizer
+		text      string
+		expected  []string
+	}{
+		{"empty_text", seq.Tokenizer{}, "", []string{}},
+		{"only_delimiters", seq.Tokenizer{}, "  \t\n", []string{}},
+		{"default_delimiters", seq.Tokenizer{}, "  hello \t world\n", []string{"hello", "world"}},
+		{"custom_delimiters", seq.Tokenizer{IsDelimiter: punctuation}, "hello, world!", []string{"hello", "world"}},
+		{"keep_delimiters", seq.Tokenizer{IsDelimiter: punctuation, KeepDelimiters: true}, "hello, world!", []string{"hello", ",", " ", "world", "!"}},
+		{"unicode", seq.Tokenizer{IsDelimiter: func(r rune) bool { return r == '·' }, KeepDelimiters: true}, "héllo·wörld", []string{"héllo", "·", "wörld"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := slices.Collect(tc.tokenizer.Tokens(tc.text))
+			if actual == nil {
+				actual = []string{}
+			}
+
+			if !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected %q, got %q", tc.expected, actual)
+			}
+		})
+	}
+}
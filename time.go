@@ -0,0 +1,370 @@
+package seq
+
+import (
+	"errors"
+	"iter"
+	"time"
+)
+
+// Clock provides the current time and timers to time-based operators (e.g. [Throttle]).
+//
+// It allows replacing the system clock in tests.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// After waits for the duration to elapse and then sends the current time on the returned channel.
+	After(d time.Duration) <-chan time.Time
+}
+
+// SystemClock is a [Clock] backed by the time package.
+type SystemClock struct{}
+
+// Now implements [Clock].
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// After implements [Clock].
+func (SystemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+func clockOrDefault(clock Clock) Clock {
+	if clock == nil {
+		return SystemClock{}
+	}
+
+	return clock
+}
+
+// Throttle creates an iterator that yields a value, then ignores subsequent values for the duration of interval.
+//
+// Values are yielded without delay; values arriving within interval after the last yielded value are dropped.
+// Use [RateLimit] to delay values instead of dropping them.
+//
+// If clock is nil, [SystemClock] is used.
+func Throttle[V any](seq iter.Seq[V], interval time.Duration, clock Clock) iter.Seq[V] {
+	clock = clockOrDefault(clock)
+
+	return func(yield func(V) bool) {
+		var (
+			last    time.Time
+			yielded bool
+		)
+
+		for v := range seq {
+			now := clock.Now()
+
+			if yielded && now.Sub(last) < interval {
+				continue
+			}
+
+			last, yielded = now, true
+
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// RateLimit creates an iterator that yields at most n values per the duration of per.
+//
+// It implements a token bucket: up to n values may be yielded in a burst,
+// after that values are delayed (by blocking the iteration) until the bucket is refilled
+// at a rate of n tokens per the duration of per.
+//
+// If n is zero or per is not positive, values are yielded without any limit.
+//
+// If clock is nil, [SystemClock] is used.
+func RateLimit[V any](seq iter.Seq[V], n uint, per time.Duration, clock Clock) iter.Seq[V] {
+	if n == 0 || per <= 0 {
+		return seq
+	}
+
+	clock = clockOrDefault(clock)
+
+	return func(yield func(V) bool) {
+		// The token bucket is implemented as a generic cell rate algorithm (GCRA)
+		// to avoid floating-point arithmetic.
+		interval := per / time.Duration(n)
+		tolerance := per - interval
+
+		// Theoretical arrival time of the next value
+		tat := clock.Now()
+
+		for v := range seq {
+			now := clock.Now()
+
+			if tat.Before(now) {
+				tat = now
+			}
+
+			if allowAt := tat.Add(-tolerance); now.Before(allowAt) {
+				<-clock.After(allowAt.Sub(now))
+			}
+
+			tat = tat.Add(interval)
+
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Debounce creates an iterator that only yields a value if no other value arrives within the duration of quiet after it.
+//
+// Since iterators cannot be interrupted by a timer, a value is yielded when the next value arrives
+// (if enough time elapsed) or when the underlying iterator ends (the last value is always yielded).
+//
+// If clock is nil, [SystemClock] is used.
+func Debounce[V any](seq iter.Seq[V], quiet time.Duration, clock Clock) iter.Seq[V] {
+	clock = clockOrDefault(clock)
+
+	return func(yield func(V) bool) {
+		var (
+			pending V
+			arrived time.Time
+			ok      bool
+		)
+
+		for v := range seq {
+			now := clock.Now()
+
+			if ok && now.Sub(arrived) >= quiet {
+				if !yield(pending) {
+					return
+				}
+			}
+
+			pending, arrived, ok = v, now, true
+		}
+
+		if ok {
+			yield(pending)
+		}
+	}
+}
+
+// Batch creates an iterator that groups values into batches.
+//
+// A batch is yielded when it reaches maxSize values or when maxWait elapsed since its first value arrived,
+// whichever comes first. The last batch is yielded when the underlying iterator ends (even if it is not full).
+// If maxSize is zero, batches are not limited in size; if maxWait is not positive, batches are not limited in time.
+//
+// Since iterators cannot be interrupted by a timer, the underlying iterator is consumed in a separate goroutine.
+// When the iteration stops, the goroutine is stopped as soon as the underlying iterator yields the next value
+// (or ends) and the iteration waits for it to return.
+// Panics in the underlying iterator are propagated to the consumer.
+//
+// Yielded batches are freshly allocated and safe to retain.
+//
+// If clock is nil, [SystemClock] is used.
+func Batch[V any](seq iter.Seq[V], maxSize uint, maxWait time.Duration, clock Clock) iter.Seq[[]V] {
+	clock = clockOrDefault(clock)
+
+	return func(yield func([]V) bool) {
+		source := goPull(seq)
+		defer source.stop(true)
+
+		var (
+			batch   []V
+			timeout <-chan time.Time
+		)
+
+		flush := func() bool {
+			b := batch
+			batch, timeout = nil, nil
+
+			return yield(b)
+		}
+
+		for {
+			select {
+			case v, ok := <-source.values:
+				if !ok {
+					source.rethrow()
+
+					if len(batch) > 0 {
+						flush()
+					}
+
+					return
+				}
+
+				if len(batch) == 0 && maxWait > 0 {
+					timeout = clock.After(maxWait)
+				}
+
+				batch = append(batch, v)
+
+				if maxSize > 0 && uint(len(batch)) >= maxSize {
+					if !flush() {
+						return
+					}
+				}
+
+			case <-timeout:
+				if !flush() {
+					return
+				}
+			}
+		}
+	}
+}
+
+// TakeFor creates an iterator that yields values until the duration of d elapses (measured from the start of the iteration).
+//
+// The elapsed time is checked when a value arrives: the first value arriving after d is dropped and the iteration stops.
+// Since iterators cannot be interrupted by a timer, TakeFor cannot stop an iterator waiting for its next value
+// (see [Timeout] for that).
+//
+// If clock is nil, [SystemClock] is used.
+func TakeFor[V any](seq iter.Seq[V], d time.Duration, clock Clock) iter.Seq[V] {
+	clock = clockOrDefault(clock)
+
+	return func(yield func(V) bool) {
+		for v := range TakeUntil(seq, clock.Now().Add(d), clock) {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// TakeUntil creates an iterator that yields values until deadline.
+//
+// The deadline is checked when a value arrives: the first value arriving at or after the deadline is dropped and the iteration stops.
+// Since iterators cannot be interrupted by a timer, TakeUntil cannot stop an iterator waiting for its next value
+// (see [Timeout] for that).
+//
+// If clock is nil, [SystemClock] is used.
+func TakeUntil[V any](seq iter.Seq[V], deadline time.Time, clock Clock) iter.Seq[V] {
+	clock = clockOrDefault(clock)
+
+	return func(yield func(V) bool) {
+		for v := range seq {
+			if !clock.Now().Before(deadline) {
+				return
+			}
+
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// ErrTimeout is yielded by [Timeout] when the underlying iterator takes too long to produce the next value.
+var ErrTimeout = errors.New("timed out waiting for the next value")
+
+// Timeout creates an iterator that yields an error if the underlying iterator
+// takes longer than d to produce its next value.
+//
+// On timeout, the iterator yields [ErrTimeout] (along with the zero value of V) and stops.
+//
+// Since iterators cannot be interrupted by a timer, the underlying iterator is consumed in a separate goroutine.
+// When the iteration stops, the goroutine is stopped as soon as the underlying iterator yields the next value
+// (or ends). On timeout, the iteration does not wait for the goroutine to return.
+// Panics in the underlying iterator are propagated to the consumer (unless the iteration timed out).
+//
+// If clock is nil, [SystemClock] is used.
+func Timeout[V any](seq iter.Seq[V], d time.Duration, clock Clock) iter.Seq2[V, error] {
+	clock = clockOrDefault(clock)
+
+	return func(yield func(V, error) bool) {
+		source := goPull(seq)
+		defer source.stop(true)
+
+		for {
+			select {
+			case v, ok := <-source.values:
+				if !ok {
+					source.rethrow()
+
+					return
+				}
+
+				if !yield(v, nil) {
+					return
+				}
+
+			case <-clock.After(d):
+				// Stop without waiting (the deferred stop is a no-op afterwards)
+				source.stop(false)
+
+				var zero V
+
+				yield(zero, ErrTimeout)
+
+				return
+			}
+		}
+	}
+}
+
+// pulledSeq is an iterator consumed in a separate goroutine.
+type pulledSeq[V any] struct {
+	values   chan V
+	done     chan struct{}
+	panicked chan any
+}
+
+// goPull starts consuming seq in a separate goroutine.
+//
+// Values are sent on the values channel, which is closed when seq ends or the consumption is stopped.
+func goPull[V any](seq iter.Seq[V]) *pulledSeq[V] {
+	p := &pulledSeq[V]{
+		values:   make(chan V),
+		done:     make(chan struct{}),
+		panicked: make(chan any, 1),
+	}
+
+	go func() {
+		defer close(p.values)
+
+		defer func() {
+			if r := recover(); r != nil {
+				p.panicked <- r
+			}
+		}()
+
+		for v := range seq {
+			select {
+			case p.values <- v:
+			case <-p.done:
+				return
+			}
+		}
+	}()
+
+	return p
+}
+
+// stop signals the goroutine to stop and optionally waits for it to return.
+func (p *pulledSeq[V]) stop(wait bool) {
+	select {
+	case <-p.done:
+		return
+	default:
+		close(p.done)
+	}
+
+	if wait {
+		for range p.values {
+		}
+	}
+}
+
+// rethrow propagates a panic from the goroutine.
+//
+// It should be called after the values channel is closed.
+func (p *pulledSeq[V]) rethrow() {
+	select {
+	case r := <-p.panicked:
+		panic(r)
+	default:
+	}
+}
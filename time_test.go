@@ -0,0 +1,527 @@
+package seq_test
+
+import (
+	"errors"
+	"iter"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sagikazarmark/seq"
+	"github.com/sagikazarmark/seq/seqtest"
+)
+
+// fakeClock is a [seq.Clock] that only moves when advanced.
+//
+// By default, waiting for a timer (using After) advances the clock immediately.
+// In manual mode, timers fire when the clock is advanced past their deadline.
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	waited []time.Duration
+	manual bool
+	timers []fakeTimer
+}
+
+type fakeTimer struct {
+	deadline time.Time
+	ch       chan time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func newManualFakeClock() *fakeClock {
+	clock := newFakeClock()
+	clock.manual = true
+
+	return clock
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.waited = append(c.waited, d)
+
+	ch := make(chan time.Time, 1)
+
+	if !c.manual {
+		c.now = c.now.Add(d)
+	}
+
+	if !c.manual || d <= 0 {
+		ch <- c.now
+
+		return ch
+	}
+
+	c.timers = append(c.timers, fakeTimer{deadline: c.now.Add(d), ch: ch})
+
+	return ch
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+
+	c.timers = slices.DeleteFunc(c.timers, func(timer fakeTimer) bool {
+		if timer.deadline.After(c.now) {
+			return false
+		}
+
+		timer.ch <- c.now
+
+		return true
+	})
+}
+
+// BlockUntil waits until n timers are waiting to fire.
+func (c *fakeClock) BlockUntil(t *testing.T, n int) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+
+	for time.Now().Before(deadline) {
+		c.mu.Lock()
+		waiting := len(c.timers)
+		c.mu.Unlock()
+
+		if waiting >= n {
+			return
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	t.Fatalf("timed out waiting for %d timers", n)
+}
+
+type timedValue struct {
+	delay time.Duration // elapsed time before the value arrives
+	value int
+}
+
+// timedSeq yields values after advancing the clock.
+func timedSeq(clock *fakeClock, values ...timedValue) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for _, v := range values {
+			clock.Advance(v.delay)
+
+			if !yield(v.value) {
+				return
+			}
+		}
+	}
+}
+
+func TestThrottle(t *testing.T) {
+	testCases := []struct {
+		name     string
+		values   []timedValue
+		expected []int
+	}{
+		{"empty_sequence", nil, nil},
+		{"single_value", []timedValue{{0, 1}}, []int{1}},
+		{"drops_values_within_interval", []timedValue{{0, 1}, {time.Second, 2}, {time.Second, 3}, {5 * time.Second, 4}}, []int{1, 4}},
+		{"values_at_interval", []timedValue{{0, 1}, {3 * time.Second, 2}, {3 * time.Second, 3}}, []int{1, 2, 3}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clock := newFakeClock()
+
+			actual := slices.Collect(seq.Throttle(timedSeq(clock, tc.values...), 3*time.Second, clock))
+
+			if !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	testCases := []struct {
+		name     string
+		values   []timedValue
+		n        uint
+		per      time.Duration
+		expected []time.Duration
+	}{
+		{"burst_within_limit", []timedValue{{0, 1}, {0, 2}, {0, 3}}, 3, time.Second, nil},
+		{
+			"burst_over_limit",
+			[]timedValue{{0, 1}, {0, 2}, {0, 3}, {0, 4}, {0, 5}},
+			2, time.Second,
+			[]time.Duration{500 * time.Millisecond, 500 * time.Millisecond, 500 * time.Millisecond},
+		},
+		{
+			"refill_between_values",
+			[]timedValue{{0, 1}, {0, 2}, {250 * time.Millisecond, 3}, {time.Second, 4}, {0, 5}},
+			2, time.Second,
+			[]time.Duration{250 * time.Millisecond},
+		},
+		{"no_limit", []timedValue{{0, 1}, {0, 2}}, 0, time.Second, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clock := newFakeClock()
+
+			actual := slices.Collect(seq.RateLimit(timedSeq(clock, tc.values...), tc.n, tc.per, clock))
+
+			if len(actual) != len(tc.values) {
+				t.Errorf("expected all values to be yielded, got %v", actual)
+			}
+
+			if !slices.Equal(clock.waited, tc.expected) {
+				t.Errorf("expected waits %v, got %v", tc.expected, clock.waited)
+			}
+		})
+	}
+}
+
+func TestRateLimit_Rate(t *testing.T) {
+	clock := newFakeClock()
+	start := clock.Now()
+
+	for range seq.RateLimit(seq.RepeatN(1, 100), 10, time.Second, clock) {
+	}
+
+	// The first 10 values are a burst, the remaining 90 are yielded at 10 per second
+	if elapsed := clock.Now().Sub(start); elapsed != 9*time.Second {
+		t.Errorf("expected 9s to elapse, got %v", elapsed)
+	}
+}
+
+func TestDebounce(t *testing.T) {
+	testCases := []struct {
+		name     string
+		values   []timedValue
+		expected []int
+	}{
+		{"empty_sequence", nil, nil},
+		{"single_value", []timedValue{{0, 1}}, []int{1}},
+		{"burst", []timedValue{{0, 1}, {time.Second, 2}, {time.Second, 3}}, []int{3}},
+		{
+			"bursts_separated_by_quiet_periods",
+			[]timedValue{{0, 1}, {time.Second, 2}, {5 * time.Second, 3}, {time.Second, 4}, {3 * time.Second, 5}},
+			[]int{2, 4, 5},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clock := newFakeClock()
+
+			actual := slices.Collect(seq.Debounce(timedSeq(clock, tc.values...), 3*time.Second, clock))
+
+			if !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestDebounce_StopEarly(t *testing.T) {
+	clock := newFakeClock()
+
+	values := timedSeq(clock, timedValue{0, 1}, timedValue{5 * time.Second, 2}, timedValue{5 * time.Second, 3})
+
+	var actual []int
+
+	for v := range seq.Debounce(values, time.Second, clock) {
+		actual = append(actual, v)
+
+		break
+	}
+
+	if expected := []int{1}; !slices.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+func TestSystemClock(t *testing.T) {
+	var clock seq.Clock = seq.SystemClock{}
+
+	start := clock.Now()
+
+	<-clock.After(time.Millisecond)
+
+	if elapsed := clock.Now().Sub(start); elapsed < time.Millisecond {
+		t.Errorf("expected at least 1ms to elapse, got %v", elapsed)
+	}
+}
+
+func TestBatch(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    []int
+		maxSize  uint
+		expected [][]int
+	}{
+		{"empty_sequence", []int{}, 3, [][]int{}},
+		{"exact_batches", []int{1, 2, 3, 4, 5, 6}, 3, [][]int{{1, 2, 3}, {4, 5, 6}}},
+		{"partial_last_batch", []int{1, 2, 3, 4, 5, 6, 7}, 3, [][]int{{1, 2, 3}, {4, 5, 6}, {7}}},
+		{"single_batch", []int{1, 2}, 3, [][]int{{1, 2}}},
+		{"unlimited_size", []int{1, 2, 3}, 0, [][]int{{1, 2, 3}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clock := newManualFakeClock()
+
+			actual := collectSlices(seq.Batch(slices.Values(tc.input), tc.maxSize, time.Minute, clock))
+
+			if !equalSlices(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestBatch_Timeout(t *testing.T) {
+	clock := newManualFakeClock()
+
+	source := make(chan int)
+	values := func(yield func(int) bool) {
+		for v := range source {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+
+	batches := make(chan []int)
+
+	go func() {
+		defer close(batches)
+
+		for batch := range seq.Batch(values, 3, time.Second, clock) {
+			batches <- batch
+		}
+	}()
+
+	// Full batch before the timeout
+	source <- 1
+	source <- 2
+	source <- 3
+
+	if batch := <-batches; !slices.Equal(batch, []int{1, 2, 3}) {
+		t.Errorf("expected [1 2 3], got %v", batch)
+	}
+
+	// Partial batch after the timeout
+	source <- 4
+
+	clock.BlockUntil(t, 2) // the timer of the first batch is still registered
+	clock.Advance(time.Second)
+
+	if batch := <-batches; !slices.Equal(batch, []int{4}) {
+		t.Errorf("expected [4], got %v", batch)
+	}
+
+	// Last batch when the source ends
+	source <- 5
+	source <- 6
+	close(source)
+
+	if batch := <-batches; !slices.Equal(batch, []int{5, 6}) {
+		t.Errorf("expected [5 6], got %v", batch)
+	}
+
+	if batch, ok := <-batches; ok {
+		t.Errorf("expected no more batches, got %v", batch)
+	}
+}
+
+func TestBatch_StopEarly(t *testing.T) {
+	tracker := &stopTracker{}
+	clock := newManualFakeClock()
+
+	var count int
+
+	seqtest.AssertNoLeak(t, func() {
+		for range seq.Batch(tracker.track(seq.Repeat(1)), 2, time.Second, clock) {
+			count++
+
+			if count == 2 {
+				break
+			}
+		}
+	})
+
+	tracker.assertStopped(t)
+}
+
+func TestBatch_Panic(t *testing.T) {
+	values := func(yield func(int) bool) {
+		yield(1)
+
+		panic("source failed")
+	}
+
+	defer func() {
+		if r := recover(); r != "source failed" {
+			t.Errorf("expected panic to be propagated, got %v", r)
+		}
+	}()
+
+	for range seq.Batch(values, 10, 0, nil) {
+		t.Error("expected no batches")
+	}
+}
+
+func TestTakeFor(t *testing.T) {
+	testCases := []struct {
+		name     string
+		values   []timedValue
+		expected []int
+	}{
+		{"empty_sequence", nil, nil},
+		{"all_values_in_time", []timedValue{{0, 1}, {time.Second, 2}, {time.Second, 3}}, []int{1, 2, 3}},
+		{"stops_after_duration", []timedValue{{0, 1}, {time.Second, 2}, {2 * time.Second, 3}, {0, 4}}, []int{1, 2}},
+		{"first_value_too_late", []timedValue{{5 * time.Second, 1}}, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clock := newFakeClock()
+
+			actual := slices.Collect(seq.TakeFor(timedSeq(clock, tc.values...), 3*time.Second, clock))
+
+			if !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestTakeFor_Reiterate(t *testing.T) {
+	clock := newFakeClock()
+
+	values := timedSeq(clock, timedValue{0, 1}, timedValue{2 * time.Second, 2})
+	taken := seq.TakeFor(values, 3*time.Second, clock)
+
+	// The duration is measured from the start of each iteration
+	for range 2 {
+		if actual := slices.Collect(taken); !slices.Equal(actual, []int{1, 2}) {
+			t.Errorf("expected [1 2], got %v", actual)
+		}
+	}
+}
+
+func TestTakeUntil(t *testing.T) {
+	clock := newFakeClock()
+
+	values := timedSeq(clock, timedValue{0, 1}, timedValue{time.Second, 2}, timedValue{time.Second, 3})
+	deadline := clock.Now().Add(2 * time.Second)
+
+	actual := slices.Collect(seq.TakeUntil(values, deadline, clock))
+
+	if expected := []int{1, 2}; !slices.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	values := slices.Values([]int{1, 2, 3})
+
+	var actual []int
+
+	for v, err := range seq.Timeout(values, time.Second, newManualFakeClock()) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		actual = append(actual, v)
+	}
+
+	if expected := []int{1, 2, 3}; !slices.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+func TestTimeout_TimedOut(t *testing.T) {
+	clock := newManualFakeClock()
+
+	source := make(chan int)
+	defer close(source)
+
+	values := func(yield func(int) bool) {
+		for v := range source {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+
+	type result struct {
+		value int
+		err   error
+	}
+
+	results := make(chan result)
+
+	go func() {
+		defer close(results)
+
+		for v, err := range seq.Timeout(values, time.Second, clock) {
+			results <- result{v, err}
+		}
+	}()
+
+	source <- 1
+
+	if r := <-results; r.value != 1 || r.err != nil {
+		t.Errorf("expected 1, got %v", r)
+	}
+
+	clock.BlockUntil(t, 2) // the timer of the first value is still registered
+	clock.Advance(time.Second)
+
+	if r := <-results; !errors.Is(r.err, seq.ErrTimeout) {
+		t.Errorf("expected %v, got %v", seq.ErrTimeout, r)
+	}
+
+	if r, ok := <-results; ok {
+		t.Errorf("expected no more values, got %v", r)
+	}
+}
+
+func TestTimeout_StopEarly(t *testing.T) {
+	tracker := &stopTracker{}
+
+	seqtest.AssertNoLeak(t, func() {
+		for range seq.Timeout(tracker.track(seq.Repeat(1)), time.Second, newManualFakeClock()) {
+			break
+		}
+	})
+
+	tracker.assertStopped(t)
+}
+
+func TestTimeout_ConsumerPanic(t *testing.T) {
+	seqtest.AssertNoLeak(t, func() {
+		r := catchPanic(func() {
+			for range seq.Timeout(seq.Repeat(1), time.Hour, newManualFakeClock()) {
+				panic("consumer failed")
+			}
+		})
+
+		if r != "consumer failed" {
+			t.Errorf("expected consumer panic to propagate, got %v", r)
+		}
+	})
+}
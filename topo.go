@@ -0,0 +1,96 @@
+package seq
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// CycleError is returned by [TopoSort] when the graph contains a cycle.
+type CycleError[N any] struct {
+	// Path lists the nodes forming the cycle.
+	// The first and the last element of the path are the same node.
+	Path []N
+}
+
+func (e *CycleError[N]) Error() string {
+	nodes := make([]string, 0, len(e.Path))
+
+	for _, n := range e.Path {
+		nodes = append(nodes, fmt.Sprint(n))
+	}
+
+	return "cycle detected: " + strings.Join(nodes, " -> ")
+}
+
+// TopoSort creates an iterator that yields nodes in dependency order (topological order).
+//
+// The edges function returns the dependencies of a node (or nil if it has none).
+// Every node is yielded after all of its dependencies.
+// Dependencies that are not part of nodes are yielded as well.
+//
+// Nodes are yielded lazily: a node is yielded as soon as all of its dependencies are yielded.
+// The order is deterministic as long as nodes and edges are.
+//
+// If the graph contains a cycle, the iterator yields a [*CycleError] (along with the zero value of N) and stops.
+func TopoSort[N comparable](nodes iter.Seq[N], edges func(N) iter.Seq[N]) iter.Seq2[N, error] {
+	return func(yield func(N, error) bool) {
+		const (
+			visiting = iota + 1
+			visited
+		)
+
+		state := make(map[N]uint8)
+
+		// path holds the nodes currently being visited
+		var path []N
+
+		// visit returns false if the iteration should stop
+		var visit func(n N) bool
+
+		visit = func(n N) bool {
+			switch state[n] {
+			case visited:
+				return true
+
+			case visiting:
+				cycle := slices.Clone(path[slices.Index(path, n):])
+				cycle = append(cycle, n)
+
+				var zero N
+
+				yield(zero, &CycleError[N]{Path: cycle})
+
+				return false
+			}
+
+			state[n] = visiting
+			path = append(path, n)
+
+			var deps iter.Seq[N]
+			if edges != nil {
+				deps = edges(n)
+			}
+
+			if deps != nil {
+				for dep := range deps {
+					if !visit(dep) {
+						return false
+					}
+				}
+			}
+
+			path = path[:len(path)-1]
+			state[n] = visited
+
+			return yield(n, nil)
+		}
+
+		for n := range nodes {
+			if !visit(n) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package seq_test
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/sagikazarmark/seq"
+)
+
+func ExampleTopoSort() {
+	dependencies := map[string][]string{
+		"app":    {"lib", "config"},
+		"lib":    {"config"},
+		"config": nil,
+	}
+
+	edges := func(n string) iter.Seq[string] {
+		return slices.Values(dependencies[n])
+	}
+
+	for target, err := range seq.TopoSort(slices.Values([]string{"app"}), edges) {
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Println(target)
+	}
+
+	// Output:
+	// config
+	// lib
+	// app
+}
+
+func ExampleTopoSort_cycle() {
+	dependencies := map[string][]string{
+		"a": {"b"},
+		"b": {"c"},
+		"c": {"a"},
+	}
+
+	edges := func(n string) iter.Seq[string] {
+		return slices.Values(dependencies[n])
+	}
+
+	for _, err := range seq.TopoSort(slices.Values([]string{"a"}), edges) {
+		if err != nil {
+			fmt.Println(err)
+		}
+	}
+
+	// Output:
+	// cycle detected: a -> b -> c -> a
+}
@@ -0,0 +1,131 @@
+package seq_test
+
+import (
+	"errors"
+	"iter"
+	"slices"
+	"testing"
+
+	"github.com/sagikazarmark/seq"
+)
+
+// graphEdges returns nil for nodes without an entry in the graph (i.e. nodes without dependencies).
+func graphEdges(graph map[string][]string) func(string) iter.Seq[string] {
+	return func(n string) iter.Seq[string] {
+		deps, ok := graph[n]
+		if !ok {
+			return nil
+		}
+
+		return slices.Values(deps)
+	}
+}
+
+func TestTopoSort(t *testing.T) {
+	testCases := []struct {
+		name     string
+		nodes    []string
+		graph    map[string][]string
+		expected []string
+	}{
+		{"empty_graph", []string{}, map[string][]string{}, []string{}},
+		{"no_edges", []string{"a", "b", "c"}, map[string][]string{}, []string{"a", "b", "c"}},
+		{"empty_edges", []string{"a", "b"}, map[string][]string{"a": {}, "b": {"a"}}, []string{"a", "b"}},
+		{"linear", []string{"a"}, map[string][]string{"a": {"b"}, "b": {"c"}}, []string{"c", "b", "a"}},
+		{"diamond", []string{"a", "b", "c", "d"}, map[string][]string{"a": {"b", "c"}, "b": {"d"}, "c": {"d"}}, []string{"d", "b", "c", "a"}},
+		{"undeclared_dependency", []string{"a"}, map[string][]string{"a": {"x"}}, []string{"x", "a"}},
+		{"duplicate_nodes", []string{"a", "a", "b"}, map[string][]string{"b": {"a"}}, []string{"a", "b"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual []string
+
+			for n, err := range seq.TopoSort(slices.Values(tc.nodes), graphEdges(tc.graph)) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				actual = append(actual, n)
+			}
+
+			if !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestTopoSort_Cycle(t *testing.T) {
+	testCases := []struct {
+		name     string
+		nodes    []string
+		graph    map[string][]string
+		yielded  []string
+		expected []string
+	}{
+		{"self_loop", []string{"a"}, map[string][]string{"a": {"a"}}, nil, []string{"a", "a"}},
+		{"two_nodes", []string{"a"}, map[string][]string{"a": {"b"}, "b": {"a"}}, nil, []string{"a", "b", "a"}},
+		{"cycle_after_valid_nodes", []string{"x", "a"}, map[string][]string{"a": {"b"}, "b": {"c"}, "c": {"b"}}, []string{"x"}, []string{"b", "c", "b"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				yielded []string
+				errs    []error
+			)
+
+			for n, err := range seq.TopoSort(slices.Values(tc.nodes), graphEdges(tc.graph)) {
+				if err != nil {
+					errs = append(errs, err)
+
+					continue
+				}
+
+				yielded = append(yielded, n)
+			}
+
+			if !slices.Equal(yielded, tc.yielded) {
+				t.Errorf("expected %v to be yielded, got %v", tc.yielded, yielded)
+			}
+
+			if len(errs) != 1 {
+				t.Fatalf("expected exactly one error, got %v", errs)
+			}
+
+			var cycleErr *seq.CycleError[string]
+			if !errors.As(errs[0], &cycleErr) {
+				t.Fatalf("expected a cycle error, got %v", errs[0])
+			}
+
+			if !slices.Equal(cycleErr.Path, tc.expected) {
+				t.Errorf("expected cycle %v, got %v", tc.expected, cycleErr.Path)
+			}
+		})
+	}
+}
+
+func TestTopoSort_StopEarly(t *testing.T) {
+	graph := map[string][]string{"a": {"b", "c"}, "b": {"c"}}
+
+	var actual []string
+
+	for n, err := range seq.TopoSort(slices.Values([]string{"a"}), graphEdges(graph)) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		actual = append(actual, n)
+
+		if len(actual) == 2 {
+			break
+		}
+	}
+
+	expected := []string{"c", "b"}
+
+	if !slices.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
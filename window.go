@@ -0,0 +1,270 @@
+package seq
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+	"time"
+)
+
+// Window is a time interval assigned to values by event time windowing (e.g. [TumblingWindow]).
+//
+// The interval is half-open: Start is inclusive, End is exclusive.
+type Window struct {
+	Start time.Time
+	End   time.Time
+}
+
+// WindowOption configures event time windowing (e.g. [TumblingWindow]).
+type WindowOption func(*windowConfig)
+
+type windowConfig struct {
+	allowedLateness time.Duration
+}
+
+// WithAllowedLateness sets how far behind the latest event time values may arrive (defaults to zero).
+//
+// The watermark (the point in event time up to which all values are assumed to have arrived)
+// trails the latest event time seen by the allowed lateness.
+// Windows are yielded once the watermark passes their end;
+// values arriving after all of their windows have been yielded are dropped.
+//
+// Negative durations are treated as zero.
+func WithAllowedLateness(d time.Duration) WindowOption {
+	return func(c *windowConfig) {
+		c.allowedLateness = max(d, 0)
+	}
+}
+
+func newWindowConfig(opts []WindowOption) windowConfig {
+	var config windowConfig
+
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return config
+}
+
+// TumblingWindow creates an iterator that groups values into fixed-size, non-overlapping windows
+// based on their event time (returned by timestamp).
+//
+// Windows are aligned to multiples of size (see [time.Time.Truncate]).
+//
+// Windows are yielded (in order) once the watermark passes their end (see [WithAllowedLateness]),
+// remaining windows are yielded when the underlying iterator ends.
+// Values in a window are in arrival order.
+// Empty windows are never yielded.
+//
+// If size is not positive, nothing is yielded.
+func TumblingWindow[V any](seq iter.Seq[V], timestamp func(V) time.Time, size time.Duration, opts ...WindowOption) iter.Seq2[Window, []V] {
+	return HoppingWindow(seq, timestamp, size, size, opts...)
+}
+
+// HoppingWindow creates an iterator that groups values into fixed-size windows starting every hop
+// based on their event time (returned by timestamp).
+//
+// If hop is smaller than size, windows overlap and values may belong to multiple windows.
+// If hop is larger than size, there are gaps between windows and values may not belong to any windows.
+// Windows are aligned to multiples of hop (see [time.Time.Truncate]).
+//
+// Windows are yielded (in order) once the watermark passes their end (see [WithAllowedLateness]),
+// remaining windows are yielded when the underlying iterator ends.
+// Values in a window are in arrival order.
+// Empty windows are never yielded.
+//
+// If size or hop is not positive, nothing is yielded.
+func HoppingWindow[V any](seq iter.Seq[V], timestamp func(V) time.Time, size time.Duration, hop time.Duration, opts ...WindowOption) iter.Seq2[Window, []V] {
+	config := newWindowConfig(opts)
+
+	return func(yield func(Window, []V) bool) {
+		if size <= 0 || hop <= 0 {
+			return
+		}
+
+		type window struct {
+			Window
+			values []V
+		}
+
+		// Open windows sorted by start (and end, since all windows are the same size)
+		var (
+			open      []*window
+			watermark time.Time
+			started   bool
+		)
+
+		for v := range seq {
+			t := timestamp(v)
+
+			// Find the windows the value belongs to, starting with the latest one
+			for start := t.Truncate(hop); start.Add(size).After(t); start = start.Add(-hop) {
+				end := start.Add(size)
+
+				// The window has already been yielded
+				if started && !end.After(watermark) {
+					continue
+				}
+
+				i, found := slices.BinarySearchFunc(open, start, func(w *window, start time.Time) int {
+					return w.Start.Compare(start)
+				})
+				if !found {
+					open = slices.Insert(open, i, &window{Window: Window{Start: start, End: end}})
+				}
+
+				open[i].values = append(open[i].values, v)
+			}
+
+			if wm := t.Add(-config.allowedLateness); !started || wm.After(watermark) {
+				watermark, started = wm, true
+			}
+
+			for len(open) > 0 && !open[0].End.After(watermark) {
+				w := open[0]
+				open = open[1:]
+
+				if !yield(w.Window, w.values) {
+					return
+				}
+			}
+		}
+
+		for _, w := range open {
+			if !yield(w.Window, w.values) {
+				return
+			}
+		}
+	}
+}
+
+// SessionWindow creates an iterator that groups values into sessions based on their event time (returned by timestamp).
+//
+// A session is a period of activity followed by a period of inactivity of (at least) gap:
+// values less than gap apart belong to the same session.
+// The window of a session starts at the event time of its first value and ends gap after its last value.
+// Late values may merge sessions.
+//
+// Sessions are yielded (in the order of their end) once the watermark passes their end (see [WithAllowedLateness]),
+// remaining sessions are yielded when the underlying iterator ends.
+// Values in a session are in arrival order.
+//
+// If gap is not positive, nothing is yielded.
+func SessionWindow[V any](seq iter.Seq[V], timestamp func(V) time.Time, gap time.Duration, opts ...WindowOption) iter.Seq2[Window, []V] {
+	config := newWindowConfig(opts)
+
+	return func(yield func(Window, []V) bool) {
+		if gap <= 0 {
+			return
+		}
+
+		type entry struct {
+			index uint64 // arrival order
+			value V
+		}
+
+		type session struct {
+			Window
+			entries []entry
+		}
+
+		values := func(s *session) []V {
+			result := make([]V, 0, len(s.entries))
+
+			for _, e := range s.entries {
+				result = append(result, e.value)
+			}
+
+			return result
+		}
+
+		// Open sessions sorted by start (sessions never overlap)
+		var (
+			open      []*session
+			watermark time.Time
+			started   bool
+			index     uint64
+		)
+
+		for v := range seq {
+			t := timestamp(v)
+
+			current := &session{
+				Window:  Window{Start: t, End: t.Add(gap)},
+				entries: []entry{{index: index, value: v}},
+			}
+
+			index++
+
+			var merged bool
+
+			open = slices.DeleteFunc(open, func(s *session) bool {
+				if !s.Start.Before(current.End) || !current.Start.Before(s.End) {
+					return false
+				}
+
+				if s.Start.Before(current.Start) {
+					current.Start = s.Start
+				}
+
+				if s.End.After(current.End) {
+					current.End = s.End
+				}
+
+				current.entries = append(current.entries, s.entries...)
+				merged = true
+
+				return true
+			})
+
+			// Drop late values that cannot be part of an open session
+			if merged || !started || current.End.After(watermark) {
+				slices.SortFunc(current.entries, func(a entry, b entry) int {
+					return cmp.Compare(a.index, b.index)
+				})
+
+				i, _ := slices.BinarySearchFunc(open, current.Start, func(s *session, start time.Time) int {
+					return s.Start.Compare(start)
+				})
+
+				open = slices.Insert(open, i, current)
+			}
+
+			if wm := t.Add(-config.allowedLateness); !started || wm.After(watermark) {
+				watermark, started = wm, true
+			}
+
+			var closed []*session
+
+			open = slices.DeleteFunc(open, func(s *session) bool {
+				if s.End.After(watermark) {
+					return false
+				}
+
+				closed = append(closed, s)
+
+				return true
+			})
+
+			slices.SortStableFunc(closed, func(a *session, b *session) int {
+				return a.End.Compare(b.End)
+			})
+
+			for _, s := range closed {
+				if !yield(s.Window, values(s)) {
+					return
+				}
+			}
+		}
+
+		slices.SortStableFunc(open, func(a *session, b *session) int {
+			return a.End.Compare(b.End)
+		})
+
+		for _, s := range open {
+			if !yield(s.Window, values(s)) {
+				return
+			}
+		}
+	}
+}
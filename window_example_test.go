@@ -0,0 +1,63 @@
+package seq_test
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/sagikazarmark/seq"
+)
+
+func ExampleTumblingWindow() {
+	type pageView struct {
+		at   time.Time
+		page string
+	}
+
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	views := slices.Values([]pageView{
+		{start, "/"},
+		{start.Add(20 * time.Second), "/about"},
+		{start.Add(70 * time.Second), "/"},
+		{start.Add(50 * time.Second), "/pricing"}, // out of order
+	})
+
+	timestamp := func(v pageView) time.Time { return v.at }
+
+	windows := seq.TumblingWindow(views, timestamp, time.Minute, seq.WithAllowedLateness(30*time.Second))
+
+	for window, views := range windows {
+		fmt.Printf("%s: %d views\n", window.Start.Format(time.TimeOnly), len(views))
+	}
+
+	// Output:
+	// 12:00:00: 3 views
+	// 12:01:00: 1 views
+}
+
+func ExampleSessionWindow() {
+	type click struct {
+		at     time.Time
+		button string
+	}
+
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	clicks := slices.Values([]click{
+		{start, "login"},
+		{start.Add(10 * time.Second), "search"},
+		{start.Add(20 * time.Second), "buy"},
+		{start.Add(10 * time.Minute), "logout"},
+	})
+
+	timestamp := func(c click) time.Time { return c.at }
+
+	for window, clicks := range seq.SessionWindow(clicks, timestamp, time.Minute) {
+		fmt.Printf("%s-%s: %d clicks\n", window.Start.Format(time.TimeOnly), window.End.Format(time.TimeOnly), len(clicks))
+	}
+
+	// Output:
+	// 12:00:00-12:01:20: 3 clicks
+	// 12:10:00-12:11:00: 1 clicks
+}
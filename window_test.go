@@ -0,0 +1,174 @@
+package seq_test
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/sagikazarmark/seq"
+)
+
+type event struct {
+	at    int // seconds since the epoch
+	value string
+}
+
+func eventTime(e event) time.Time {
+	return time.Unix(int64(e.at), 0)
+}
+
+func events(at ...int) []event {
+	result := make([]event, 0, len(at))
+
+	for i, t := range at {
+		result = append(result, event{at: t, value: fmt.Sprintf("e%d", i)})
+	}
+
+	return result
+}
+
+// formatWindows formats windows as "[start,end): values" where start and end are seconds since the epoch.
+func formatWindows(windows iter.Seq2[seq.Window, []event]) []string {
+	result := []string{}
+
+	for w, values := range windows {
+		var names []string
+
+		for _, v := range values {
+			names = append(names, v.value)
+		}
+
+		result = append(result, fmt.Sprintf("[%d,%d): %v", w.Start.Unix(), w.End.Unix(), names))
+	}
+
+	return result
+}
+
+func TestTumblingWindow(t *testing.T) {
+	testCases := []struct {
+		name     string
+		events   []event
+		lateness time.Duration
+		expected []string
+	}{
+		{"empty_sequence", nil, 0, []string{}},
+		{"in_order", events(0, 5, 10, 25), 0, []string{"[0,10): [e0 e1]", "[10,20): [e2]", "[20,30): [e3]"}},
+		{"out_of_order_within_lateness", events(0, 12, 5, 15), 5 * time.Second, []string{"[0,10): [e0 e2]", "[10,20): [e1 e3]"}},
+		{"late_value_dropped", events(0, 12, 5, 15), 0, []string{"[0,10): [e0]", "[10,20): [e1 e3]"}},
+		{"out_of_order_in_open_window", events(12, 11, 25), 0, []string{"[10,20): [e0 e1]", "[20,30): [e2]"}},
+		{"negative_lateness", events(0, 1, 2, 3), -10 * time.Second, []string{"[0,10): [e0 e1 e2 e3]"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			windows := seq.TumblingWindow(slices.Values(tc.events), eventTime, 10*time.Second, seq.WithAllowedLateness(tc.lateness))
+
+			actual := formatWindows(windows)
+
+			if !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected %q, got %q", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestTumblingWindow_Emission(t *testing.T) {
+	// Windows are yielded as soon as the watermark passes their end, not when the iterator ends
+	var yielded []string
+
+	values := func(yield func(event) bool) {
+		for _, e := range events(0, 5, 10, 15) {
+			yielded = append(yielded, e.value)
+
+			if !yield(e) {
+				return
+			}
+		}
+	}
+
+	for w := range seq.TumblingWindow(values, eventTime, 10*time.Second) {
+		if w.Start.Unix() == 0 && !slices.Equal(yielded, []string{"e0", "e1", "e2"}) {
+			t.Errorf("expected first window to be yielded after e2, got it after %v", yielded)
+		}
+
+		break
+	}
+}
+
+func TestHoppingWindow(t *testing.T) {
+	testCases := []struct {
+		name     string
+		events   []event
+		size     time.Duration
+		hop      time.Duration
+		expected []string
+	}{
+		{"empty_sequence", nil, 10 * time.Second, 5 * time.Second, []string{}},
+		{
+			"overlapping",
+			events(1, 6, 12),
+			10 * time.Second, 5 * time.Second,
+			[]string{"[-5,5): [e0]", "[0,10): [e0 e1]", "[5,15): [e1 e2]", "[10,20): [e2]"},
+		},
+		{
+			"gaps",
+			events(1, 6, 12),
+			5 * time.Second, 10 * time.Second,
+			[]string{"[0,5): [e0]", "[10,15): [e2]"},
+		},
+		{"invalid_hop", events(1), 10 * time.Second, 0, []string{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			windows := seq.HoppingWindow(slices.Values(tc.events), eventTime, tc.size, tc.hop)
+
+			actual := formatWindows(windows)
+
+			if !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected %q, got %q", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSessionWindow(t *testing.T) {
+	testCases := []struct {
+		name     string
+		events   []event
+		lateness time.Duration
+		expected []string
+	}{
+		{"empty_sequence", nil, 0, []string{}},
+		{"single_session", events(0, 3, 6), 0, []string{"[0,11): [e0 e1 e2]"}},
+		{"multiple_sessions", events(0, 3, 10, 12, 30), 0, []string{"[0,8): [e0 e1]", "[10,17): [e2 e3]", "[30,35): [e4]"}},
+		{"gap_boundary", events(0, 5), 0, []string{"[0,5): [e0]", "[5,10): [e1]"}},
+		{"late_value_merges_sessions", events(0, 8, 4), 10 * time.Second, []string{"[0,13): [e0 e1 e2]"}},
+		{"late_value_extends_session", events(10, 14, 7), 5 * time.Second, []string{"[7,19): [e0 e1 e2]"}},
+		{"late_value_dropped", events(0, 20, 3), 0, []string{"[0,5): [e0]", "[20,25): [e1]"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			windows := seq.SessionWindow(slices.Values(tc.events), eventTime, 5*time.Second, seq.WithAllowedLateness(tc.lateness))
+
+			actual := formatWindows(windows)
+
+			if !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected %q, got %q", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSessionWindow_StopEarly(t *testing.T) {
+	windows := seq.SessionWindow(slices.Values(events(0, 10, 20, 30)), eventTime, 5*time.Second)
+
+	actual := formatWindows(seq.Take2(windows, 2))
+
+	if expected := []string{"[0,5): [e0]", "[10,15): [e1]"}; !slices.Equal(actual, expected) {
+		t.Errorf("expected %q, got %q", expected, actual)
+	}
+}